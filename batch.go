@@ -0,0 +1,44 @@
+package usivalidator
+
+// VerifyKeys validates a batch of USIs with VerifyKey, e.g. an enrolment
+// import. The options are applied to every key, so WithFastKernel speeds up
+// large batches.
+//
+// Parameters:
+// - keys ([]string): The USIs to validate.
+// - opts (...Option): Options applied to every key.
+//
+// Returns:
+// - ([]Result): One result per key, in input order. Rules is not set. As with
+//   RuleChain.Validate, Err is set for every invalid key; a wrong check
+//   character is reported as a *ValidationError matching ErrCheckCharMismatch.
+//
+// Usage:
+// for _, result := range VerifyKeys(keys) {
+//     if !result.Valid {
+//         fmt.Println("Invalid USI:", result.Key, result.Err)
+//     }
+// }
+
+func VerifyKeys(keys []string, opts ...Option) []Result {
+	o := newOptions(opts)
+	results := make([]Result, len(keys))
+	for i, key := range keys {
+		results[i] = verifyResult(key, o)
+	}
+	return results
+}
+
+// verifyResult verifies key like VerifyKey, reporting a check character
+// mismatch as an error rather than (false, nil).
+func verifyResult(key string, o options) Result {
+	valid, err := verifyKey(key, o)
+	if !valid && err == nil {
+		if o.lenient {
+			err = mismatchError(StripSeparators(key))
+		} else {
+			err = mismatchError(key)
+		}
+	}
+	return Result{Key: key, Valid: valid, Err: err}
+}
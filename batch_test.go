@@ -0,0 +1,67 @@
+package usivalidator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifyKeys(t *testing.T) {
+	keys := []string{"BNGH7C75FN", "BNGH7C75FM", "BNGH7C75F", "bngh7c75fn"}
+
+	for _, opts := range [][]Option{nil, {WithFastKernel()}} {
+		results := VerifyKeys(keys, opts...)
+		assert.Equal(t, []Result{
+			{Key: "BNGH7C75FN", Valid: true},
+			{Key: "BNGH7C75FM", Valid: false, Err: results[1].Err},
+			{Key: "BNGH7C75F", Valid: false, Err: results[2].Err},
+			{Key: "bngh7c75fn", Valid: true},
+		}, results)
+		assert.EqualError(t, results[2].Err, "key length must be 10 characters")
+
+		var e *ValidationError
+		if assert.ErrorAs(t, results[1].Err, &e) {
+			assert.ErrorIs(t, e, ErrCheckCharMismatch)
+			assert.Equal(t, 9, e.Index)
+			assert.Equal(t, 'M', e.Char)
+			assert.Equal(t, 'N', e.Expected)
+		}
+	}
+}
+
+func TestVerifyKeysLenientMismatch(t *testing.T) {
+	results := VerifyKeys([]string{"BNGH-7C75-FM"}, WithLenient())
+	var e *ValidationError
+	if assert.ErrorAs(t, results[0].Err, &e) {
+		assert.Equal(t, ReasonCheckCharMismatch, e.Reason)
+		assert.Equal(t, 'N', e.Expected)
+	}
+}
+
+func TestVerifyKeysOptions(t *testing.T) {
+	blocklist := NewBlockset(1, 0)
+	blocklist.Add("BNGH7C75FN")
+
+	results := VerifyKeys([]string{"BNGH7C75FN"}, WithBlocklist(blocklist))
+	assert.ErrorIs(t, results[0].Err, ErrBlocked)
+}
+
+func TestVerifyKeysEmpty(t *testing.T) {
+	assert.Empty(t, VerifyKeys(nil))
+}
+
+func BenchmarkVerifyKeys(b *testing.B) {
+	keys := referenceCorpus(10000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		VerifyKeys(keys)
+	}
+}
+
+func BenchmarkVerifyKeysFastKernel(b *testing.B) {
+	keys := referenceCorpus(10000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		VerifyKeys(keys, WithFastKernel())
+	}
+}
@@ -0,0 +1,193 @@
+package usivalidator
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+)
+
+// Blockset is a set of USIs, such as revoked or blocked identifiers, that can
+// be consulted during verification.
+//
+// A Blockset is normally backed by a Bloom filter, which keeps memory use to a
+// few bits per identifier at the cost of a configurable false-positive rate.
+// When no false positives can be tolerated it falls back to an exact set. The
+// zero value is an empty exact set.
+//
+// A Blockset is safe for concurrent reads once it has been populated.
+type Blockset struct {
+	bits  []uint64
+	m     uint64
+	k     uint64
+	exact map[uint64]struct{}
+	count int
+}
+
+// NewBlockset creates an empty Blockset sized for the expected number of entries.
+//
+// Parameters:
+// - expected (int): The number of USIs the set is expected to hold.
+// - falsePositiveRate (float64): The acceptable probability that Contains reports a USI that was never added, e.g. 0.001. A rate of zero or less selects an exact set.
+//
+// Returns:
+// - (*Blockset): An empty set.
+//
+// Usage:
+// blocked := NewBlockset(1000000, 0.0001)
+// blocked.Add("BNGH7C75FN")
+// fmt.Println(blocked.Contains("BNGH7C75FN")) // true
+
+func NewBlockset(expected int, falsePositiveRate float64) *Blockset {
+	if falsePositiveRate <= 0 || falsePositiveRate >= 1 {
+		return &Blockset{exact: make(map[uint64]struct{}, expected)}
+	}
+	if expected < 1 {
+		expected = 1
+	}
+
+	m := uint64(math.Ceil(-float64(expected) * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2)))
+	k := uint64(math.Round(float64(m) / float64(expected) * math.Ln2))
+	if k < 1 {
+		k = 1
+	}
+
+	return &Blockset{
+		bits: make([]uint64, (m+63)/64),
+		m:    m,
+		k:    k,
+	}
+}
+
+// LoadBlockset reads one USI per line from r. Blank lines and lines starting
+// with '#' are ignored.
+//
+// Parameters:
+// - r (io.Reader): The source of USIs.
+// - falsePositiveRate (float64): Passed to NewBlockset once the number of entries is known.
+//
+// Returns:
+// - (*Blockset): The populated set.
+// - (error): An error if reading fails or a line does not hold a well-formed USI.
+//
+// Usage:
+// f, _ := os.Open("revoked.txt")
+// blocked, err := LoadBlockset(f, 0.0001)
+
+func LoadBlockset(r io.Reader, falsePositiveRate float64) (*Blockset, error) {
+	var values []uint64
+
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		value, err := Encode(text)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		values = append(values, value)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	b := NewBlockset(len(values), falsePositiveRate)
+	for _, value := range values {
+		b.add(value)
+	}
+	return b, nil
+}
+
+// Add inserts usi into the set.
+//
+// Parameters:
+// - usi (string): The USI to add. Must be exactly 10 valid characters; the check character is not verified.
+//
+// Returns:
+// - (error): An error if the input length is invalid or contains invalid characters.
+
+func (b *Blockset) Add(usi string) error {
+	value, err := Encode(usi)
+	if err != nil {
+		return err
+	}
+	b.add(value)
+	return nil
+}
+
+// Contains reports whether usi may be in the set. For a Bloom-backed set a
+// true result is subject to the configured false-positive rate; a false result
+// is always exact. Malformed input is never contained.
+//
+// Parameters:
+// - usi (string): The USI to look up.
+//
+// Returns:
+// - (bool): True if the USI is (probably) in the set.
+
+func (b *Blockset) Contains(usi string) bool {
+	value, err := Encode(usi)
+	if err != nil {
+		return false
+	}
+
+	if b.exact != nil || b.m == 0 {
+		_, ok := b.exact[value]
+		return ok
+	}
+
+	h1, h2 := bloomHashes(value)
+	for i := uint64(0); i < b.k; i++ {
+		bit := (h1 + i*h2) % b.m
+		if b.bits[bit/64]&(1<<(bit%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Len returns the number of USIs added to the set, including duplicates for a
+// Bloom-backed set.
+func (b *Blockset) Len() int {
+	if b.exact != nil {
+		return len(b.exact)
+	}
+	return b.count
+}
+
+func (b *Blockset) add(value uint64) {
+	if b.exact == nil && b.m == 0 {
+		b.exact = make(map[uint64]struct{})
+	}
+	if b.exact != nil {
+		b.exact[value] = struct{}{}
+		return
+	}
+
+	h1, h2 := bloomHashes(value)
+	for i := uint64(0); i < b.k; i++ {
+		bit := (h1 + i*h2) % b.m
+		b.bits[bit/64] |= 1 << (bit % 64)
+	}
+	b.count++
+}
+
+// bloomHashes derives the two hashes used for double hashing from an encoded USI.
+func bloomHashes(value uint64) (uint64, uint64) {
+	return splitmix64(value), splitmix64(value^0x9e3779b97f4a7c15) | 1
+}
+
+// splitmix64 is the finalizer from the SplitMix64 generator, used here as a fast
+// well-distributed hash for 64-bit values.
+func splitmix64(x uint64) uint64 {
+	x += 0x9e3779b97f4a7c15
+	x = (x ^ (x >> 30)) * 0xbf58476d1ce4e5b9
+	x = (x ^ (x >> 27)) * 0x94d049bb133111eb
+	return x ^ (x >> 31)
+}
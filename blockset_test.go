@@ -0,0 +1,87 @@
+package usivalidator
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockset(t *testing.T) {
+	testCases := []struct {
+		Rate     float64
+		TestName string
+	}{
+		{0, "Exact set"},
+		{0.01, "Bloom filter"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			b := NewBlockset(10, tc.Rate)
+			assert.NoError(t, b.Add("BNGH7C75FN"))
+			assert.NoError(t, b.Add("bp6lkb3c7x"))
+			assert.EqualError(t, b.Add("SHORT"), "key length must be 10 characters")
+
+			assert.True(t, b.Contains("BNGH7C75FN"))
+			assert.True(t, b.Contains("bngh7c75fn"), "Lookups are case-insensitive")
+			assert.True(t, b.Contains("BP6LKB3C7X"))
+			assert.False(t, b.Contains("RVJ5DM8LXJ"))
+			assert.False(t, b.Contains("SHORT"))
+			assert.Equal(t, 2, b.Len())
+		})
+	}
+}
+
+func TestBlocksetZeroValue(t *testing.T) {
+	var b Blockset
+	assert.False(t, b.Contains("BNGH7C75FN"), "The zero value is empty")
+	assert.Equal(t, 0, b.Len())
+
+	isValid, err := VerifyKey("BNGH7C75FN", WithBlocklist(&Blockset{}))
+	assert.True(t, isValid)
+	assert.NoError(t, err)
+
+	assert.NoError(t, b.Add("BNGH7C75FN"))
+	assert.True(t, b.Contains("BNGH7C75FN"))
+	assert.False(t, b.Contains("BP6LKB3C7X"))
+	assert.Equal(t, 1, b.Len())
+}
+
+func TestBlocksetFalsePositiveRate(t *testing.T) {
+	const n = 20000
+	b := NewBlockset(n, 0.01)
+	for i := uint64(0); i < n; i++ {
+		assert.NoError(t, b.Add(Decode(i*7919)))
+	}
+
+	for i := uint64(0); i < n; i++ {
+		if !b.Contains(Decode(i * 7919)) {
+			t.Fatalf("false negative for %s", Decode(i*7919))
+		}
+	}
+
+	falsePositives := 0
+	for i := uint64(0); i < n; i++ {
+		if b.Contains(Decode(i*7919 + 1)) {
+			falsePositives++
+		}
+	}
+	assert.Less(t, float64(falsePositives)/n, 0.02, "False-positive rate should be close to the configured rate")
+}
+
+func TestLoadBlockset(t *testing.T) {
+	input := `# revoked identifiers
+BNGH7C75FN
+
+  bp6lkb3c7x
+`
+	b, err := LoadBlockset(strings.NewReader(input), 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, b.Len())
+	assert.True(t, b.Contains("BNGH7C75FN"))
+	assert.True(t, b.Contains("BP6LKB3C7X"))
+
+	_, err = LoadBlockset(strings.NewReader("BNGH7C75FN\nBAD\n"), 0.001)
+	assert.EqualError(t, err, "line 2: key length must be 10 characters")
+}
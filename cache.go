@@ -0,0 +1,192 @@
+package usivalidator
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// Cache is a minimal key/value store with per-entry expiry. It is used by the
+// memoization and lookup features of the package so organisations can plug in
+// their own backing store (Redis, memcached, bolt, ...).
+//
+// Implementations must be safe for concurrent use.
+type Cache interface {
+	// Get returns the value stored for key and whether it was found and unexpired.
+	Get(key string) (interface{}, bool)
+	// Set stores value for key. A ttl of zero or less means the entry never expires.
+	Set(key string, value interface{}, ttl time.Duration)
+}
+
+// NopCache is a Cache that stores nothing. Every Get is a miss.
+type NopCache struct{}
+
+// Get always reports a miss.
+func (NopCache) Get(key string) (interface{}, bool) { return nil, false }
+
+// Set discards the value.
+func (NopCache) Set(key string, value interface{}, ttl time.Duration) {}
+
+// MemoryCache is an unbounded, in-process Cache. Expired entries are removed
+// lazily when they are next read.
+type MemoryCache struct {
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+	now     func() time.Time
+}
+
+type cacheEntry struct {
+	value   interface{}
+	expires time.Time
+}
+
+// NewMemoryCache creates an empty in-memory cache.
+//
+// Returns:
+// - (*MemoryCache): A cache ready for use.
+//
+// Usage:
+// cache := NewMemoryCache()
+// cache.Set("BNGH7C75FN", true, time.Hour)
+// if v, ok := cache.Get("BNGH7C75FN"); ok {
+//     fmt.Println("Cached:", v)
+// }
+
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{
+		entries: make(map[string]cacheEntry),
+		now:     time.Now,
+	}
+}
+
+// Get returns the value stored for key if it exists and has not expired.
+func (c *MemoryCache) Get(key string) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if entry.expired(c.now()) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return entry.value, true
+}
+
+// Set stores value for key, replacing any existing entry.
+func (c *MemoryCache) Set(key string, value interface{}, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[key] = newCacheEntry(value, ttl, c.now())
+}
+
+// Len returns the number of entries currently held, including any that have
+// expired but not yet been read.
+func (c *MemoryCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.entries)
+}
+
+// LRUCache is a Cache holding at most a fixed number of entries. When full,
+// setting a new key evicts the least recently used entry.
+type LRUCache struct {
+	mu    sync.Mutex
+	size  int
+	order *list.List
+	items map[string]*list.Element
+	now   func() time.Time
+}
+
+type lruItem struct {
+	key   string
+	entry cacheEntry
+}
+
+// NewLRUCache creates an empty cache bounded to size entries.
+//
+// Parameters:
+// - size (int): The maximum number of entries. Values below 1 are treated as 1.
+//
+// Returns:
+// - (*LRUCache): A cache ready for use.
+//
+// Usage:
+// cache := NewLRUCache(10000)
+
+func NewLRUCache(size int) *LRUCache {
+	if size < 1 {
+		size = 1
+	}
+	return &LRUCache{
+		size:  size,
+		order: list.New(),
+		items: make(map[string]*list.Element, size),
+		now:   time.Now,
+	}
+}
+
+// Get returns the value stored for key if it exists and has not expired, and
+// marks it as most recently used.
+func (c *LRUCache) Get(key string) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	item := elem.Value.(*lruItem)
+	if item.entry.expired(c.now()) {
+		c.order.Remove(elem)
+		delete(c.items, key)
+		return nil, false
+	}
+	c.order.MoveToFront(elem)
+	return item.entry.value, true
+}
+
+// Set stores value for key, evicting the least recently used entry if the
+// cache is full.
+func (c *LRUCache) Set(key string, value interface{}, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry := newCacheEntry(value, ttl, c.now())
+	if elem, ok := c.items[key]; ok {
+		elem.Value.(*lruItem).entry = entry
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	if c.order.Len() >= c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*lruItem).key)
+	}
+	c.items[key] = c.order.PushFront(&lruItem{key: key, entry: entry})
+}
+
+// Len returns the number of entries currently held.
+func (c *LRUCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.order.Len()
+}
+
+func newCacheEntry(value interface{}, ttl time.Duration, now time.Time) cacheEntry {
+	entry := cacheEntry{value: value}
+	if ttl > 0 {
+		entry.expires = now.Add(ttl)
+	}
+	return entry
+}
+
+func (e cacheEntry) expired(now time.Time) bool {
+	return !e.expires.IsZero() && !now.Before(e.expires)
+}
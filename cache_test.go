@@ -0,0 +1,96 @@
+package usivalidator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	_ Cache = NopCache{}
+	_ Cache = (*MemoryCache)(nil)
+	_ Cache = (*LRUCache)(nil)
+)
+
+func TestNopCache(t *testing.T) {
+	var cache NopCache
+	cache.Set("BNGH7C75FN", true, 0)
+
+	value, ok := cache.Get("BNGH7C75FN")
+	assert.False(t, ok)
+	assert.Nil(t, value)
+}
+
+func TestMemoryCache(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	cache := NewMemoryCache()
+	cache.now = func() time.Time { return now }
+
+	cache.Set("forever", 1, 0)
+	cache.Set("short", 2, time.Minute)
+
+	testCases := []struct {
+		Key      string
+		Advance  time.Duration
+		Expected interface{}
+		Found    bool
+		TestName string
+	}{
+		{"forever", 0, 1, true, "Entry without TTL"},
+		{"short", 0, 2, true, "Entry before expiry"},
+		{"missing", 0, nil, false, "Missing entry"},
+		{"short", time.Minute, nil, false, "Entry at expiry"},
+		{"forever", time.Hour, 1, true, "Entry without TTL never expires"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			now = now.Add(tc.Advance)
+			value, ok := cache.Get(tc.Key)
+			assert.Equal(t, tc.Found, ok)
+			assert.Equal(t, tc.Expected, value)
+		})
+	}
+
+	assert.Equal(t, 1, cache.Len(), "Expired entry should be removed on read")
+}
+
+func TestLRUCache(t *testing.T) {
+	cache := NewLRUCache(2)
+	cache.Set("a", 1, 0)
+	cache.Set("b", 2, 0)
+
+	_, ok := cache.Get("a") // "a" becomes most recently used
+	assert.True(t, ok)
+
+	cache.Set("c", 3, 0) // evicts "b"
+	assert.Equal(t, 2, cache.Len())
+
+	_, ok = cache.Get("b")
+	assert.False(t, ok, "Least recently used entry should be evicted")
+
+	value, ok := cache.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, value)
+
+	cache.Set("a", 4, 0)
+	value, _ = cache.Get("a")
+	assert.Equal(t, 4, value, "Set should replace an existing entry")
+	assert.Equal(t, 2, cache.Len())
+}
+
+func TestLRUCacheExpiry(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	cache := NewLRUCache(0)
+	cache.now = func() time.Time { return now }
+
+	cache.Set("a", 1, time.Second)
+	_, ok := cache.Get("a")
+	assert.True(t, ok)
+
+	now = now.Add(time.Second)
+	_, ok = cache.Get("a")
+	assert.False(t, ok)
+	assert.Equal(t, 0, cache.Len())
+}
@@ -0,0 +1,25 @@
+package usivalidator
+
+// CompleteUSI appends the check character to a 9-character prefix.
+//
+// Parameters:
+// - prefix (string): The first 9 characters of the USI. Case is ignored.
+//
+// Returns:
+// - (string): The full 10-character USI in uppercase.
+// - (error): A *ValidationError if the prefix length is not 9 or it contains invalid characters.
+//
+// Usage:
+// usi, err := CompleteUSI("BNGH7C75F")
+// if err == nil {
+//     fmt.Println(usi) // BNGH7C75FN
+// }
+
+func CompleteUSI(prefix string) (string, error) {
+	prefix = upperASCII(prefix)
+	checkChar, err := GenerateCheckCharacter(prefix)
+	if err != nil {
+		return "", err
+	}
+	return prefix + string(checkChar), nil
+}
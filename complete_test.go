@@ -0,0 +1,33 @@
+package usivalidator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompleteUSI(t *testing.T) {
+	testCases := []struct {
+		Prefix   string
+		Expected string
+		Error    string
+		TestName string
+	}{
+		{"BNGH7C75F", "BNGH7C75FN", "", "Valid prefix"},
+		{"bngh7c75f", "BNGH7C75FN", "", "Lowercase prefix"},
+		{"BNGH7C75", "", "input length must be 9 characters", "Too short"},
+		{"BNGH7C7OF", "", "invalid character in input", "Invalid character"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			usi, err := CompleteUSI(tc.Prefix)
+			assert.Equal(t, tc.Expected, usi)
+			if tc.Error != "" {
+				assert.EqualError(t, err, tc.Error)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
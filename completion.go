@@ -0,0 +1,55 @@
+package usivalidator
+
+import "strings"
+
+// Completion is one way to finish a partially entered USI.
+type Completion struct {
+	// Suffix holds the characters still to be typed: the check character for
+	// a 9-character entry, or the ninth character followed by the check
+	// character it requires for an 8-character entry.
+	Suffix string
+	// USI is the completed, checksum-valid USI.
+	USI string
+}
+
+// ValidCompletions lists the endings that turn an 8- or 9-character entry into
+// a checksum-valid USI, in sorted order. A 9-character entry has exactly one
+// completion. An 8-character entry has one per possible ninth character,
+// telling a form which check character each choice will require.
+//
+// Parameters:
+// - partial (string): The first 8 or 9 characters of a USI. Case is ignored.
+//
+// Returns:
+// - ([]Completion): The valid completions.
+// - (error): An error if partial has the wrong length or contains invalid characters.
+//
+// Usage:
+// completions, err := ValidCompletions("BNGH7C75F")
+// if err == nil && !strings.HasSuffix(input, completions[0].Suffix) {
+//     fmt.Println("The last character should be", completions[0].Suffix) // N
+// }
+
+func ValidCompletions(partial string) ([]Completion, error) {
+	if len(partial) != 8 && len(partial) != 9 {
+		return nil, lengthError(len(partial), "partial length must be 8 or 9 characters")
+	}
+
+	partial = upperASCII(partial)
+	for _, char := range partial {
+		if indexOf(char, ValidCharacters) == -1 {
+			return nil, characterError(partial, ValidCharacters)
+		}
+	}
+
+	usis, err := Expand(partial + strings.Repeat(string(Wildcard), 10-len(partial)))
+	if err != nil {
+		return nil, err
+	}
+
+	completions := make([]Completion, len(usis))
+	for i, usi := range usis {
+		completions[i] = Completion{Suffix: usi[len(partial):], USI: usi}
+	}
+	return completions, nil
+}
@@ -0,0 +1,50 @@
+package usivalidator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidCompletions(t *testing.T) {
+	testCases := []struct {
+		Partial  string
+		Count    int
+		First    Completion
+		Error    string
+		TestName string
+	}{
+		{"BNGH7C75F", 1, Completion{"N", "BNGH7C75FN"}, "", "Nine characters"},
+		{"bngh7c75f", 1, Completion{"N", "BNGH7C75FN"}, "", "Lowercase input"},
+		{"BNGH7C75", 32, Completion{"2" + string(mustCheckChar("BNGH7C752")), "BNGH7C752" + string(mustCheckChar("BNGH7C752"))}, "", "Eight characters"},
+		{"BNGH7C7", 0, Completion{}, "partial length must be 8 or 9 characters", "Too short"},
+		{"BNGH7C75FN", 0, Completion{}, "partial length must be 8 or 9 characters", "Too long"},
+		{"BNGH7C7?F", 0, Completion{}, "invalid character in input", "Wildcard is not accepted"},
+		{"BNGH7C7OF", 0, Completion{}, "invalid character in input", "Invalid character"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			completions, err := ValidCompletions(tc.Partial)
+			if tc.Error != "" {
+				assert.EqualError(t, err, tc.Error)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, completions, tc.Count)
+			assert.Equal(t, tc.First, completions[0])
+			for _, c := range completions {
+				isValid, _ := VerifyKey(c.USI)
+				assert.True(t, isValid, c.USI)
+			}
+		})
+	}
+}
+
+func mustCheckChar(prefix string) rune {
+	checkChar, err := GenerateCheckCharacter(prefix)
+	if err != nil {
+		panic(err)
+	}
+	return checkChar
+}
@@ -0,0 +1,102 @@
+package usivalidator
+
+import (
+	"bufio"
+	_ "embed"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+//go:embed conformance_vectors.txt
+var conformanceVectors []byte
+
+// ConformanceVector is a single embedded test vector: a key and the expected
+// outcome of VerifyKey, one of "valid", "invalid" or "error".
+type ConformanceVector struct {
+	Key      string
+	Expected string
+}
+
+// ConformanceVectors returns the test vectors embedded in the package.
+//
+// Returns:
+// - ([]ConformanceVector): The vectors, in file order.
+// - (error): An error if a line is not a key followed by "valid", "invalid" or
+//   "error", which means the embedded file has been corrupted or edited.
+//
+// Usage:
+// vectors, err := ConformanceVectors()
+// if err != nil {
+//     log.Fatal(err)
+// }
+// for _, v := range vectors {
+//     fmt.Println(v.Key, v.Expected)
+// }
+
+func ConformanceVectors() ([]ConformanceVector, error) {
+	var vectors []ConformanceVector
+
+	scanner := bufio.NewScanner(strings.NewReader(string(conformanceVectors)))
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("conformance: line %d: expected a key and an outcome, got %q", lineNumber, line)
+		}
+		switch fields[1] {
+		case "valid", "invalid", "error":
+		default:
+			return nil, fmt.Errorf("conformance: line %d: unknown outcome %q", lineNumber, fields[1])
+		}
+		vectors = append(vectors, ConformanceVector{Key: fields[0], Expected: fields[1]})
+	}
+	return vectors, scanner.Err()
+}
+
+// RunConformance checks VerifyKey against every embedded test vector, so a
+// deployment can prove at startup that this build computes check characters
+// as expected.
+//
+// Returns:
+// - (error): nil if every vector passes, otherwise an error describing each
+//   failure, or why the vectors could not be parsed.
+//
+// Usage:
+// if err := RunConformance(); err != nil {
+//     log.Fatal(err)
+// }
+
+func RunConformance() error {
+	vectors, err := ConformanceVectors()
+	if err != nil {
+		return err
+	}
+	if len(vectors) == 0 {
+		return errors.New("conformance: no test vectors")
+	}
+
+	var failures []error
+	for _, v := range vectors {
+		if got := conformanceOutcome(v.Key); got != v.Expected {
+			failures = append(failures, fmt.Errorf("conformance: %s: expected %s, got %s", v.Key, v.Expected, got))
+		}
+	}
+	return errors.Join(failures...)
+}
+
+func conformanceOutcome(key string) string {
+	isValid, err := VerifyKey(key)
+	switch {
+	case err != nil:
+		return "error"
+	case isValid:
+		return "valid"
+	}
+	return "invalid"
+}
@@ -0,0 +1,57 @@
+package usivalidator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConformanceVectors(t *testing.T) {
+	vectors, err := ConformanceVectors()
+	assert.NoError(t, err)
+	assert.NotEmpty(t, vectors)
+	assert.Equal(t, ConformanceVector{Key: "BNGH7C75FN", Expected: "valid"}, vectors[0])
+
+	for _, v := range vectors {
+		assert.Contains(t, []string{"valid", "invalid", "error"}, v.Expected, v.Key)
+	}
+}
+
+func TestRunConformance(t *testing.T) {
+	assert.NoError(t, RunConformance())
+}
+
+func TestRunConformanceDetectsFailure(t *testing.T) {
+	original := conformanceVectors
+	t.Cleanup(func() { conformanceVectors = original })
+
+	conformanceVectors = []byte("# comment\nBNGH7C75FN invalid\nBNGH7C75FM invalid\nSHORT valid\n")
+	assert.EqualError(t, RunConformance(),
+		"conformance: BNGH7C75FN: expected invalid, got valid\nconformance: SHORT: expected valid, got error")
+
+	conformanceVectors = nil
+	assert.EqualError(t, RunConformance(), "conformance: no test vectors")
+}
+
+func TestRunConformanceDetectsCorruption(t *testing.T) {
+	original := conformanceVectors
+	t.Cleanup(func() { conformanceVectors = original })
+
+	testCases := []struct {
+		Vectors  string
+		Expected string
+		TestName string
+	}{
+		{"BNGH7C75FN valid\nBNGH7C75FM\n", `conformance: line 2: expected a key and an outcome, got "BNGH7C75FM"`, "Missing outcome"},
+		{"BNGH7C75FN valid extra\n", `conformance: line 1: expected a key and an outcome, got "BNGH7C75FN valid extra"`, "Extra field"},
+		{"# comment\nBNGH7C75FN vaild\n", `conformance: line 2: unknown outcome "vaild"`, "Unknown outcome"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			conformanceVectors = []byte(tc.Vectors)
+			assert.EqualError(t, RunConformance(), tc.Expected)
+			assert.ErrorContains(t, SelfTest(), tc.Expected)
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package csvx
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/chrisjoyce911/usivalidator"
+)
+
+// extraSeparators are removed from identifiers by Canonicalize in addition to
+// the whitespace and dashes removed by usivalidator.StripSeparators, as exports
+// sometimes group USI characters with them.
+const extraSeparators = "._/"
+
+// Change records one identifier rewritten by Canonicalize.
+type Change struct {
+	// Line is the input line on which the record starts.
+	Line int
+	// Column is the header name, or empty when columns are selected by index.
+	Column string
+	// Index is the zero-based position of the column in the record.
+	Index     int
+	Original  string
+	Canonical string
+}
+
+// Canonicalize reads the remaining records and writes a copy to out in which
+// every identifier column holding a USI or exemption code is in canonical
+// form: uppercase, with whitespace, dashes, dots, underscores and slashes
+// removed. Cleaned USIs are checked with the reader's Verifier; values that are
+// not valid once cleaned are copied unchanged so they can be followed up at
+// the source. The header row, if any, is copied as is and out uses the
+// reader's delimiter. Records processed before an error are still written.
+//
+// If changelog is not nil, a CSV log with the columns line, column, index,
+// original and canonical is written to it, one row per rewritten value.
+//
+// Parameters:
+// - out (io.Writer): Receives the canonical copy of the input.
+// - changelog (io.Writer): Receives the change log, or nil.
+//
+// Returns:
+// - ([]Change): The rewritten values, in input order.
+// - (error): A read or write error.
+//
+// Usage:
+// reader := csvx.NewReader(in, "USI")
+// changes, err := reader.Canonicalize(out, changelog)
+// if err != nil {
+//     log.Fatal(err)
+// }
+// fmt.Println(len(changes), "USIs normalized")
+
+func (r *Reader) Canonicalize(out, changelog io.Writer) ([]Change, error) {
+	w := csv.NewWriter(out)
+	w.Comma = r.Comma
+
+	var log *csv.Writer
+	if changelog != nil {
+		log = csv.NewWriter(changelog)
+	}
+
+	changes, err := r.canonicalizeRecords(w, log)
+	w.Flush()
+	if err == nil {
+		err = w.Error()
+	}
+	if log != nil {
+		log.Flush()
+		if err == nil {
+			err = log.Error()
+		}
+	}
+	return changes, err
+}
+
+// canonicalizeRecords implements Canonicalize, leaving the writers unflushed.
+func (r *Reader) canonicalizeRecords(w, log *csv.Writer) ([]Change, error) {
+	if log != nil {
+		if err := log.Write([]string{"line", "column", "index", "original", "canonical"}); err != nil {
+			return nil, err
+		}
+	}
+
+	var changes []Change
+	wroteHeader := r.header != nil
+	for {
+		record, err := r.Read()
+		if !wroteHeader && r.header != nil {
+			if err := w.Write(r.header); err != nil {
+				return changes, err
+			}
+			wroteHeader = true
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return changes, err
+		}
+
+		for _, field := range r.fields {
+			if field.Err == ErrMissingField {
+				continue
+			}
+			canonical, ok := r.canonicalize(field.Value)
+			if !ok || canonical == field.Value {
+				continue
+			}
+
+			change := Change{
+				Line:      r.Line(),
+				Column:    field.Column,
+				Index:     field.Index,
+				Original:  field.Value,
+				Canonical: canonical,
+			}
+			changes = append(changes, change)
+			record[field.Index] = canonical
+
+			if log != nil {
+				row := []string{strconv.Itoa(change.Line), change.Column, strconv.Itoa(change.Index), change.Original, change.Canonical}
+				if err := log.Write(row); err != nil {
+					return changes, err
+				}
+			}
+		}
+
+		if err := w.Write(record); err != nil {
+			return changes, err
+		}
+	}
+	return changes, nil
+}
+
+// canonicalize returns the canonical form of value and whether it is a valid
+// USI, according to r.Verifier, or exemption code once cleaned.
+func (r *Reader) canonicalize(value string) (string, bool) {
+	cleaned := usivalidator.StripSeparators(strings.Map(func(char rune) rune {
+		if strings.ContainsRune(extraSeparators, char) {
+			return -1
+		}
+		return char
+	}, value))
+
+	if usi, err := usivalidator.Normalize(cleaned); err == nil {
+		if isValid, _ := r.Verifier.VerifyKey(usi); isValid {
+			return usi, true
+		}
+		return value, false
+	}
+
+	// Exemption codes contain characters outside the USI alphabet, so
+	// Normalize rejects them.
+	code := strings.ToUpper(cleaned)
+	for _, exemption := range usivalidator.ExemptionCodes {
+		if code == exemption {
+			return code, true
+		}
+	}
+	return value, false
+}
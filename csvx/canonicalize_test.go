@@ -0,0 +1,93 @@
+package csvx
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/chrisjoyce911/usivalidator"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanonicalize(t *testing.T) {
+	input := "Name\tUSI\n" +
+		"Ann\tBNGH7C75FN\n" +
+		"Bo\tbngh-7c75 fn\n" +
+		"Cy\t indiv\n" +
+		"Di\tInt-Off\n" +
+		"Ed\tBNGH7C75FM\n" +
+		"Fi\tBNGH.7C75/FN\n" +
+		"Gu\tbngh_7c75_fn\n"
+
+	reader := NewReader(strings.NewReader(input), "USI")
+	reader.Comma = '\t'
+
+	var out, changelog strings.Builder
+	changes, err := reader.Canonicalize(&out, &changelog)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "Name\tUSI\n"+
+		"Ann\tBNGH7C75FN\n"+
+		"Bo\tBNGH7C75FN\n"+
+		"Cy\tINDIV\n"+
+		"Di\tINTOFF\n"+
+		"Ed\tBNGH7C75FM\n"+
+		"Fi\tBNGH7C75FN\n"+
+		"Gu\tBNGH7C75FN\n", out.String())
+
+	assert.Equal(t, []Change{
+		{Line: 3, Column: "USI", Index: 1, Original: "bngh-7c75 fn", Canonical: "BNGH7C75FN"},
+		{Line: 4, Column: "USI", Index: 1, Original: " indiv", Canonical: "INDIV"},
+		{Line: 5, Column: "USI", Index: 1, Original: "Int-Off", Canonical: "INTOFF"},
+		{Line: 7, Column: "USI", Index: 1, Original: "BNGH.7C75/FN", Canonical: "BNGH7C75FN"},
+		{Line: 8, Column: "USI", Index: 1, Original: "bngh_7c75_fn", Canonical: "BNGH7C75FN"},
+	}, changes)
+
+	assert.Equal(t, "line,column,index,original,canonical\n"+
+		"3,USI,1,bngh-7c75 fn,BNGH7C75FN\n"+
+		"4,USI,1,\" indiv\",INDIV\n"+
+		"5,USI,1,Int-Off,INTOFF\n"+
+		"7,USI,1,BNGH.7C75/FN,BNGH7C75FN\n"+
+		"8,USI,1,bngh_7c75_fn,BNGH7C75FN\n", changelog.String())
+}
+
+func TestCanonicalizeFlushesOnError(t *testing.T) {
+	reader := NewReader(strings.NewReader("USI\nbngh7c75fn\n\"BP6LKB3C7X\"x\n"), "USI")
+
+	var out, changelog strings.Builder
+	changes, err := reader.Canonicalize(&out, &changelog)
+	assert.Error(t, err)
+	assert.Len(t, changes, 1)
+	assert.Equal(t, "USI\nBNGH7C75FN\n", out.String(), "Records before the error should be written")
+	assert.Equal(t, "line,column,index,original,canonical\n2,USI,0,bngh7c75fn,BNGH7C75FN\n", changelog.String())
+}
+
+func TestCanonicalizeUsesVerifier(t *testing.T) {
+	reader := NewReader(strings.NewReader("USI\nbngh7c75fn\nbp6lkb3c7x\n"), "USI")
+	reader.Verifier = usivalidator.Chain(usivalidator.FormatRule(), usivalidator.ChecksumRule(), usivalidator.BannedPrefixRule("BNGH"))
+
+	var out strings.Builder
+	changes, err := reader.Canonicalize(&out, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []Change{{Line: 3, Column: "USI", Index: 0, Original: "bp6lkb3c7x", Canonical: "BP6LKB3C7X"}}, changes)
+	assert.Equal(t, "USI\nbngh7c75fn\nBP6LKB3C7X\n", out.String(), "USIs rejected by the verifier should be copied unchanged")
+}
+
+func TestCanonicalizeWithoutChangelog(t *testing.T) {
+	reader := NewReaderIndexes(strings.NewReader("bngh7c75fn,x\n"), 0)
+
+	var out strings.Builder
+	changes, err := reader.Canonicalize(&out, nil)
+	assert.NoError(t, err)
+	assert.Len(t, changes, 1)
+	assert.Equal(t, "BNGH7C75FN,x\n", out.String())
+}
+
+func TestCanonicalizeHeaderOnly(t *testing.T) {
+	reader := NewReader(strings.NewReader("Name,USI\n"), "USI")
+
+	var out strings.Builder
+	changes, err := reader.Canonicalize(&out, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, changes)
+	assert.Equal(t, "Name,USI\n", out.String())
+}
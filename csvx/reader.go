@@ -0,0 +1,226 @@
+/*
+Package csvx wraps encoding/csv with on-the-fly USI validation, so ingestion
+code can validate identifier columns as records are read instead of making a
+separate pass over the file.
+*/
+package csvx
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/chrisjoyce911/usivalidator"
+)
+
+// ErrMissingField is reported for a configured column that is absent from a short record.
+var ErrMissingField = errors.New("column missing from record")
+
+// Field is the validation state of one identifier column in a record.
+type Field struct {
+	// Column is the header name, or empty when columns are selected by index.
+	Column string
+	// Index is the zero-based position of the column in the record.
+	Index int
+	Value string
+	Valid bool
+	Err   error
+}
+
+// Reader reads CSV records and validates the configured identifier columns of
+// each record as it is read.
+type Reader struct {
+	// Verifier validates each identifier. It defaults to usivalidator.DefaultVerifier
+	// and may be replaced before the first call to Read, e.g. with a RuleChain.
+	Verifier usivalidator.Verifier
+
+	// Comma is the field delimiter. It defaults to ',' and may be set to any
+	// single rune, such as '\t' for TSV or '|' for pipe-delimited exports.
+	Comma rune
+	// Comment, if not 0, marks lines starting with it as comments to skip.
+	Comment rune
+	// LazyQuotes allows quotes to appear in unquoted fields and non-doubled
+	// quotes in quoted fields, as produced by some SMS exports.
+	LazyQuotes bool
+	// TrimLeadingSpace ignores leading white space in fields.
+	TrimLeadingSpace bool
+
+	r          *csv.Reader
+	names      []string
+	indexes    []int
+	header     []string
+	fields     []Field
+	line       int
+	headerErr  error
+	started    bool
+	configured bool
+}
+
+// NewReader returns a Reader whose input starts with a header row. The named
+// columns are validated in every following record.
+//
+// Parameters:
+// - r (io.Reader): The CSV input.
+// - columns (...string): The header names of the identifier columns.
+//
+// Returns:
+// - (*Reader): The reader.
+//
+// Usage:
+// reader := csvx.NewReader(file, "USI")
+// for {
+//     record, err := reader.Read()
+//     if err == io.EOF {
+//         break
+//     }
+//     if err != nil {
+//         log.Fatal(err)
+//     }
+//     if !reader.Valid() {
+//         fmt.Println("Invalid USI in", record)
+//     }
+// }
+
+func NewReader(r io.Reader, columns ...string) *Reader {
+	return &Reader{
+		Verifier: usivalidator.DefaultVerifier,
+		Comma:    ',',
+		r:        csv.NewReader(r),
+		names:    columns,
+	}
+}
+
+// NewReaderIndexes returns a Reader for input without a header row. The
+// columns at the given zero-based indexes are validated in every record.
+//
+// Parameters:
+// - r (io.Reader): The CSV input.
+// - indexes (...int): The positions of the identifier columns.
+//
+// Returns:
+// - (*Reader): The reader.
+
+func NewReaderIndexes(r io.Reader, indexes ...int) *Reader {
+	return &Reader{
+		Verifier: usivalidator.DefaultVerifier,
+		Comma:    ',',
+		r:        csv.NewReader(r),
+		indexes:  indexes,
+		started:  true,
+	}
+}
+
+// Read reads the next data record and validates its identifier columns. The
+// header row, if any, is consumed by the first call, which also applies the
+// delimiter and quoting settings. Read returns io.EOF at the end of the input.
+//
+// Returns:
+// - ([]string): The record.
+// - (error): A read error, or an error if a named column is missing from the
+//   header. An error reading the header is returned by every later call.
+
+func (r *Reader) Read() ([]string, error) {
+	if !r.configured {
+		r.configure()
+	}
+	if !r.started {
+		r.started = true
+		r.headerErr = r.readHeader()
+	}
+	if r.headerErr != nil {
+		return nil, r.headerErr
+	}
+
+	record, err := r.r.Read()
+	if err != nil {
+		r.fields = nil
+		return nil, err
+	}
+
+	r.line, _ = r.r.FieldPos(0)
+	if r.fields == nil {
+		r.fields = make([]Field, 0, len(r.indexes))
+	}
+	r.fields = r.fields[:0]
+	for i, index := range r.indexes {
+		field := Field{Index: index}
+		if r.names != nil {
+			field.Column = r.names[i]
+		}
+		if index >= len(record) {
+			field.Err = ErrMissingField
+		} else {
+			field.Value = record[index]
+			field.Valid, field.Err = r.Verifier.VerifyKey(field.Value)
+		}
+		r.fields = append(r.fields, field)
+	}
+	return record, nil
+}
+
+// Header returns the header row. It is nil until the first call to Read, and
+// always nil for readers created with NewReaderIndexes.
+func (r *Reader) Header() []string {
+	return r.header
+}
+
+// Fields returns the validation state of each identifier column in the record
+// most recently returned by Read, in the order the columns were configured.
+// The slice is reused by the next call to Read.
+func (r *Reader) Fields() []Field {
+	return r.fields
+}
+
+// Valid reports whether every identifier column in the record most recently
+// returned by Read is valid. It is false before the first record and after a
+// failed Read.
+func (r *Reader) Valid() bool {
+	if r.fields == nil {
+		return false
+	}
+	for _, field := range r.fields {
+		if !field.Valid {
+			return false
+		}
+	}
+	return true
+}
+
+// Line returns the input line on which the record most recently returned by
+// Read starts, or 0 if no record has been read yet.
+func (r *Reader) Line() int {
+	return r.line
+}
+
+func (r *Reader) configure() {
+	r.configured = true
+	r.r.Comma = r.Comma
+	r.r.Comment = r.Comment
+	r.r.LazyQuotes = r.LazyQuotes
+	r.r.TrimLeadingSpace = r.TrimLeadingSpace
+}
+
+func (r *Reader) readHeader() error {
+	header, err := r.r.Read()
+	if err != nil {
+		return err
+	}
+	r.header = header
+
+	positions := make(map[string]int, len(header))
+	for i, name := range header {
+		if _, seen := positions[name]; !seen {
+			positions[name] = i
+		}
+	}
+
+	for _, name := range r.names {
+		index, ok := positions[name]
+		if !ok {
+			return fmt.Errorf("csvx: column %q not found in header", name)
+		}
+		r.indexes = append(r.indexes, index)
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+package csvx
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/chrisjoyce911/usivalidator"
+	"github.com/stretchr/testify/assert"
+)
+
+func ExampleReader() {
+	input := "name,usi\nAlex,BNGH7C75FN\nSam,BNGH7C75FX\n"
+
+	reader := NewReader(strings.NewReader(input), "usi")
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			fmt.Println("Error:", err)
+			return
+		}
+		fmt.Println(record[0], reader.Valid())
+	}
+
+	// Output:
+	// Alex true
+	// Sam false
+}
+
+func TestReader(t *testing.T) {
+	input := "name,usi,previous_usi\n" +
+		"Alex,BNGH7C75FN,BP6LKB3C7X\n" +
+		"Sam,BNGH7C75FX,RVJ5DM8LXJ\n" +
+		"Jo,SHORT,\n"
+
+	reader := NewReader(strings.NewReader(input), "usi", "previous_usi")
+
+	record, err := reader.Read()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Alex", "BNGH7C75FN", "BP6LKB3C7X"}, record)
+	assert.Equal(t, []string{"name", "usi", "previous_usi"}, reader.Header())
+	assert.Equal(t, 2, reader.Line())
+	assert.True(t, reader.Valid())
+	assert.Equal(t, []Field{
+		{Column: "usi", Index: 1, Value: "BNGH7C75FN", Valid: true},
+		{Column: "previous_usi", Index: 2, Value: "BP6LKB3C7X", Valid: true},
+	}, reader.Fields())
+
+	_, err = reader.Read()
+	assert.NoError(t, err)
+	assert.False(t, reader.Valid())
+	assert.False(t, reader.Fields()[0].Valid)
+	assert.NoError(t, reader.Fields()[0].Err)
+	assert.True(t, reader.Fields()[1].Valid)
+
+	_, err = reader.Read()
+	assert.NoError(t, err)
+	assert.Equal(t, 4, reader.Line())
+	assert.EqualError(t, reader.Fields()[0].Err, "key length must be 10 characters")
+	assert.EqualError(t, reader.Fields()[1].Err, "key length must be 10 characters")
+
+	_, err = reader.Read()
+	assert.Equal(t, io.EOF, err)
+	assert.Empty(t, reader.Fields())
+}
+
+func TestReaderMissingColumn(t *testing.T) {
+	reader := NewReader(strings.NewReader("name,id\nAlex,BNGH7C75FN\n"), "usi")
+	_, err := reader.Read()
+	assert.EqualError(t, err, `csvx: column "usi" not found in header`)
+
+	record, err := reader.Read()
+	assert.Nil(t, record)
+	assert.EqualError(t, err, `csvx: column "usi" not found in header`, "The header error should be returned by every Read")
+	assert.False(t, reader.Valid())
+}
+
+func TestReaderIndexes(t *testing.T) {
+	reader := NewReaderIndexes(strings.NewReader("BNGH7C75FN,Alex\nBNGH7C75FX,Sam\n"), 0)
+
+	_, err := reader.Read()
+	assert.NoError(t, err)
+	assert.Nil(t, reader.Header())
+	assert.True(t, reader.Valid())
+	assert.Equal(t, Field{Index: 0, Value: "BNGH7C75FN", Valid: true}, reader.Fields()[0])
+
+	_, err = reader.Read()
+	assert.NoError(t, err)
+	assert.False(t, reader.Valid())
+}
+
+func TestReaderMissingField(t *testing.T) {
+	reader := NewReaderIndexes(strings.NewReader("BNGH7C75FN\n"), 0, 1)
+
+	_, err := reader.Read()
+	assert.NoError(t, err)
+	assert.False(t, reader.Valid())
+	assert.True(t, reader.Fields()[0].Valid)
+	assert.Equal(t, ErrMissingField, reader.Fields()[1].Err)
+}
+
+func TestReaderCustomVerifier(t *testing.T) {
+	reader := NewReader(strings.NewReader("usi\nINTOFF\n"), "usi")
+	reader.Verifier = usivalidator.Chain(usivalidator.ExemptionRule(), usivalidator.FormatRule(), usivalidator.ChecksumRule())
+
+	_, err := reader.Read()
+	assert.NoError(t, err)
+	assert.True(t, reader.Valid())
+}
+
+func TestReaderDelimiters(t *testing.T) {
+	testCases := []struct {
+		Input      string
+		Comma      rune
+		LazyQuotes bool
+		TestName   string
+	}{
+		{"name\tusi\nAlex\tBNGH7C75FN\n", '\t', false, "Tab-delimited"},
+		{"name|usi\nAlex|BNGH7C75FN\n", '|', false, "Pipe-delimited"},
+		{"name;usi\nAlex;BNGH7C75FN\n", ';', false, "Semicolon-delimited"},
+		{"name|usi\nAl \"Lex\" Smith|BNGH7C75FN\n", '|', true, "Pipe-delimited with stray quotes"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			reader := NewReader(strings.NewReader(tc.Input), "usi")
+			reader.Comma = tc.Comma
+			reader.LazyQuotes = tc.LazyQuotes
+
+			record, err := reader.Read()
+			assert.NoError(t, err)
+			assert.Len(t, record, 2)
+			assert.True(t, reader.Valid())
+			assert.Equal(t, "BNGH7C75FN", reader.Fields()[0].Value)
+		})
+	}
+}
+
+func TestReaderStrictQuotes(t *testing.T) {
+	reader := NewReader(strings.NewReader("name|usi\nAl \"Lex\" Smith|BNGH7C75FN\n"), "usi")
+	reader.Comma = '|'
+
+	_, err := reader.Read()
+	assert.Error(t, err, "Stray quotes are rejected unless LazyQuotes is set")
+}
+
+func TestReaderCommentAndTrim(t *testing.T) {
+	reader := NewReaderIndexes(strings.NewReader("# export header\nAlex, BNGH7C75FN\n"), 1)
+	reader.Comment = '#'
+	reader.TrimLeadingSpace = true
+
+	_, err := reader.Read()
+	assert.NoError(t, err)
+	assert.True(t, reader.Valid())
+}
+
+func TestReaderLineBeforeRead(t *testing.T) {
+	reader := NewReader(strings.NewReader("USI\nBNGH7C75FN\n"), "USI")
+	assert.Equal(t, 0, reader.Line(), "Line should be 0 before the first Read")
+	assert.False(t, reader.Valid(), "No record is current before the first Read")
+
+	_, err := reader.Read()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, reader.Line())
+	assert.True(t, reader.Valid())
+
+	_, err = reader.Read()
+	assert.Equal(t, io.EOF, err)
+	assert.Equal(t, 2, reader.Line(), "Line should keep the last record's line at EOF")
+	assert.False(t, reader.Valid(), "No record is current after a failed Read")
+}
@@ -0,0 +1,80 @@
+package usivalidator
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// RenderDiagnostic explains why key is not a valid USI in the style of a
+// compiler diagnostic: the input on one line, then a caret under the offending
+// character followed by a one-line explanation. It returns an empty string if
+// key is valid.
+//
+// Problems are reported in order of usefulness: an invalid character first,
+// then a wrong length, then a wrong check character.
+//
+// Parameters:
+// - key (string): The USI as entered.
+//
+// Returns:
+// - (string): The two-line diagnostic, or "" if the USI is valid.
+//
+// Usage:
+// fmt.Println(RenderDiagnostic("BNGH7C7OFN"))
+// // BNGH7C7OFN
+// //        ^ 'O' is not a valid USI character (I, O, 0 and 1 are never used)
+
+func RenderDiagnostic(key string) string {
+	e := diagnose(key)
+	if e == nil {
+		return ""
+	}
+
+	position, message := e.Index, ""
+	switch e.Reason {
+	case ReasonInvalidCharacter:
+		message = describeInvalidCharacter(e.Char)
+	case ReasonInvalidLength:
+		position = min(e.Length, 10)
+		message = fmt.Sprintf("expected 10 characters, got %d", e.Length)
+	case ReasonCheckCharMismatch:
+		message = fmt.Sprintf("check character should be '%c', not '%c'", e.Expected, e.Char)
+	}
+	return fmt.Sprintf("%s\n%s^ %s", key, strings.Repeat(" ", position), message)
+}
+
+// diagnose returns the first problem with key, or nil if it is valid. Unlike
+// VerifyKey, it checks characters before the length, counts characters rather
+// than bytes, and reports characters as entered.
+func diagnose(key string) *ValidationError {
+	chars := []rune(key)
+	for i, char := range chars {
+		if indexOf(unicode.ToUpper(char), ValidCharacters) == -1 {
+			return &ValidationError{Reason: ReasonInvalidCharacter, Index: i, Char: char, msg: "invalid character in input"}
+		}
+	}
+
+	if len(chars) != 10 {
+		return lengthError(len(chars), "key length must be 10 characters")
+	}
+
+	if isValid, _ := VerifyKey(key); isValid {
+		return nil
+	}
+	e := mismatchError(key)
+	e.Char = chars[9]
+	return e
+}
+
+func describeInvalidCharacter(char rune) string {
+	switch {
+	case strings.ContainsRune("IiOo01", char):
+		return fmt.Sprintf("'%c' is not a valid USI character (I, O, 0 and 1 are never used)", char)
+	case unicode.IsSpace(char):
+		return "whitespace is not allowed in a USI"
+	case unicode.IsPrint(char):
+		return fmt.Sprintf("'%c' is not a valid USI character", char)
+	}
+	return fmt.Sprintf("%q is not a valid USI character", char)
+}
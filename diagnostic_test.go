@@ -0,0 +1,41 @@
+package usivalidator
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func ExampleRenderDiagnostic() {
+	fmt.Println(RenderDiagnostic("BNGH7C75FX"))
+
+	// Output:
+	// BNGH7C75FX
+	//          ^ check character should be 'N', not 'X'
+}
+
+func TestRenderDiagnostic(t *testing.T) {
+	testCases := []struct {
+		Key      string
+		Expected string
+		TestName string
+	}{
+		{"BNGH7C75FN", "", "Valid USI"},
+		{"bngh7c75fn", "", "Valid lowercase USI"},
+		{"BNGH7C7OFN", "BNGH7C7OFN\n       ^ 'O' is not a valid USI character (I, O, 0 and 1 are never used)", "Letter O"},
+		{"BNGH7C7$FN", "BNGH7C7$FN\n       ^ '$' is not a valid USI character", "Special character"},
+		{"BNGH 7C75F", "BNGH 7C75F\n    ^ whitespace is not allowed in a USI", "Whitespace"},
+		{"BNGH7C75F", "BNGH7C75F\n         ^ expected 10 characters, got 9", "Too short"},
+		{"BNGH7C75FNN", "BNGH7C75FNN\n          ^ expected 10 characters, got 11", "Too long"},
+		{"", "\n^ expected 10 characters, got 0", "Empty"},
+		{"bngh7c75fx", "bngh7c75fx\n         ^ check character should be 'N', not 'x'", "Wrong lowercase check character"},
+		{"BNGH7C7ÉFN", "BNGH7C7ÉFN\n       ^ 'É' is not a valid USI character", "Multi-byte character"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			assert.Equal(t, tc.Expected, RenderDiagnostic(tc.Key))
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package usivalidator
+
+import (
+	"math"
+	"math/rand/v2"
+)
+
+// chiSquareCritical is the chi-square critical value for 31 degrees of freedom
+// (32 check characters) at a significance level of 0.001.
+const chiSquareCritical = 61.098
+
+// outlierSigma is how many standard deviations a single check character's count
+// may stray from its expected value before it is reported as an outlier.
+const outlierSigma = 4.0
+
+// minExpectedCount is the smallest expected count per check character for
+// which the chi-square and outlier tests are applied.
+const minExpectedCount = 5
+
+// Distribution summarises how often each check character was generated for a
+// corpus. For uniformly random prefixes every check character is equally
+// likely, so a skewed distribution points at a biased synthetic-data generator
+// or a systematically corrupted import.
+type Distribution struct {
+	// Counts holds the number of times each check character was generated.
+	Counts map[rune]int
+	// Total is the number of prefixes counted.
+	Total int
+	// Skipped is the number of inputs that were not valid prefixes.
+	Skipped int
+	// ChiSquare is the chi-square statistic against a uniform distribution.
+	ChiSquare float64
+	// Anomalous is true if ChiSquare exceeds the critical value at p = 0.001.
+	// It is never set when there are fewer than five expected occurrences per
+	// character, as the test is unreliable for small samples.
+	Anomalous bool
+	// Outliers lists check characters whose counts are more than four
+	// standard deviations from expected, in alphabet order. Like Anomalous,
+	// it is always empty for small samples.
+	Outliers []rune
+}
+
+// CheckCharDistribution computes the distribution of check characters for a
+// corpus of 9-character prefixes. Full 10-character USIs are also accepted; only
+// their first nine characters are used.
+//
+// Parameters:
+// - prefixes ([]string): The corpus to analyse.
+//
+// Returns:
+// - (Distribution): The observed distribution and anomaly flags.
+//
+// Usage:
+// dist := CheckCharDistribution(prefixes)
+// if dist.Anomalous {
+//     fmt.Println("Unexpected check characters:", string(dist.Outliers))
+// }
+
+func CheckCharDistribution(prefixes []string) Distribution {
+	d := Distribution{Counts: make(map[rune]int, len(ValidCharacters))}
+	for _, char := range ValidCharacters {
+		d.Counts[char] = 0
+	}
+
+	for _, prefix := range prefixes {
+		if len(prefix) == 10 {
+			prefix = prefix[:9]
+		}
+		checkChar, err := GenerateCheckCharacter(prefix)
+		if err != nil {
+			d.Skipped++
+			continue
+		}
+		d.Counts[checkChar]++
+		d.Total++
+	}
+
+	d.analyse()
+	return d
+}
+
+// SampleCheckCharDistribution computes the distribution of check characters for
+// n uniformly random prefixes. It is a baseline to compare real corpora against.
+//
+// Parameters:
+// - n (int): The number of prefixes to sample. Values below 1 give an empty distribution.
+// - r (*rand.Rand): The random source. If nil, the global source is used.
+//
+// Returns:
+// - (Distribution): The sampled distribution.
+
+func SampleCheckCharDistribution(n int, r *rand.Rand) Distribution {
+	intN := rand.IntN
+	if r != nil {
+		intN = r.IntN
+	}
+
+	prefixes := make([]string, max(n, 0))
+	buf := make([]rune, 9)
+	for i := range prefixes {
+		for j := range buf {
+			buf[j] = ValidCharacters[intN(len(ValidCharacters))]
+		}
+		prefixes[i] = string(buf)
+	}
+	return CheckCharDistribution(prefixes)
+}
+
+func (d *Distribution) analyse() {
+	if d.Total == 0 {
+		return
+	}
+
+	p := 1 / float64(len(ValidCharacters))
+	expected := float64(d.Total) * p
+	stddev := math.Sqrt(expected * (1 - p))
+	reliable := expected >= minExpectedCount
+
+	for _, char := range ValidCharacters {
+		diff := float64(d.Counts[char]) - expected
+		d.ChiSquare += diff * diff / expected
+		if reliable && math.Abs(diff) > outlierSigma*stddev {
+			d.Outliers = append(d.Outliers, char)
+		}
+	}
+
+	d.Anomalous = reliable && d.ChiSquare > chiSquareCritical
+}
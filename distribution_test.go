@@ -0,0 +1,58 @@
+package usivalidator
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckCharDistribution(t *testing.T) {
+	d := CheckCharDistribution([]string{"BNGH7C75F", "BNGH7C75FN", "BP6LKB3C7", "SHORT", "BNGH7C7OF"})
+	assert.Equal(t, 3, d.Total)
+	assert.Equal(t, 2, d.Skipped)
+	assert.Equal(t, 2, d.Counts['N'])
+	assert.Equal(t, 1, d.Counts['X'])
+	assert.Equal(t, 0, d.Counts['2'])
+	assert.Len(t, d.Counts, 32)
+	assert.False(t, d.Anomalous, "Small samples are never flagged")
+	assert.Empty(t, d.Outliers, "Small samples have no outliers")
+
+	d = CheckCharDistribution([]string{"BNGH7C75F"})
+	assert.Empty(t, d.Outliers, "A single prefix has no outliers")
+}
+
+func TestCheckCharDistributionEmpty(t *testing.T) {
+	d := CheckCharDistribution(nil)
+	assert.Equal(t, 0, d.Total)
+	assert.Zero(t, d.ChiSquare)
+	assert.False(t, d.Anomalous)
+}
+
+func TestSampleCheckCharDistributionEmpty(t *testing.T) {
+	for _, n := range []int{0, -1} {
+		d := SampleCheckCharDistribution(n, nil)
+		assert.Equal(t, 0, d.Total)
+		assert.False(t, d.Anomalous)
+	}
+}
+
+func TestSampleCheckCharDistributionIsUniform(t *testing.T) {
+	d := SampleCheckCharDistribution(32000, rand.New(rand.NewPCG(1, 2)))
+	assert.Equal(t, 32000, d.Total)
+	assert.False(t, d.Anomalous, "Random prefixes should give uniform check characters (chi-square %.2f)", d.ChiSquare)
+	assert.Empty(t, d.Outliers)
+}
+
+func TestCheckCharDistributionDetectsBias(t *testing.T) {
+	// A generator that only varies the last prefix character over half the
+	// alphabet can only ever produce half of the check characters.
+	var prefixes []string
+	for i := 0; i < 1000; i++ {
+		prefixes = append(prefixes, "BNGH7C75"+string(ValidCharacters[i%16]))
+	}
+
+	d := CheckCharDistribution(prefixes)
+	assert.True(t, d.Anomalous)
+	assert.Len(t, d.Outliers, 32, "Every character is either over or under represented")
+}
@@ -0,0 +1,66 @@
+package usivalidator
+
+// bitsPerChar is the number of bits needed to store one character of the 32-symbol alphabet.
+const bitsPerChar = 5
+
+// Encode packs a 10-character USI into the low 50 bits of a uint64, five bits
+// per character with the first character in the most significant position.
+// Because ValidCharacters is in ascending order, comparing encoded values gives
+// the same ordering as comparing the USI strings.
+//
+// Encode checks the length and character set but not the check character, so
+// invalid USIs can still be stored and reported on.
+//
+// Parameters:
+// - usi (string): The USI to encode. Must be exactly 10 characters long.
+//
+// Returns:
+// - (uint64): The encoded USI.
+// - (error): An error if the input length is invalid or contains invalid characters.
+//
+// Usage:
+// value, err := Encode("BNGH7C75FN")
+// if err != nil {
+//     log.Println("Error:", err)
+// } else {
+//     fmt.Println(Decode(value)) // BNGH7C75FN
+// }
+
+func Encode(usi string) (uint64, error) {
+	if len(usi) != 10 {
+		return 0, lengthError(len(usi), "key length must be 10 characters")
+	}
+
+	usi = upperASCII(usi)
+	var value uint64
+	for i := 0; i < len(usi); i++ {
+		codePoint := indexOf(rune(usi[i]), ValidCharacters)
+		if codePoint == -1 {
+			return 0, characterError(usi, ValidCharacters)
+		}
+		value = value<<bitsPerChar | uint64(codePoint)
+	}
+
+	return value, nil
+}
+
+// Decode unpacks a value produced by Encode back into its 10-character USI.
+// Bits above the low 50 are ignored.
+//
+// Parameters:
+// - value (uint64): The encoded USI.
+//
+// Returns:
+// - (string): The decoded USI.
+//
+// Usage:
+// usi := Decode(value)
+
+func Decode(value uint64) string {
+	var usi [10]rune
+	for i := len(usi) - 1; i >= 0; i-- {
+		usi[i] = ValidCharacters[value&(1<<bitsPerChar-1)]
+		value >>= bitsPerChar
+	}
+	return string(usi[:])
+}
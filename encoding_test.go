@@ -0,0 +1,76 @@
+package usivalidator
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func ExampleEncode() {
+	value, err := Encode("BNGH7C75FN")
+	if err != nil {
+		fmt.Println("Error:", err)
+		return
+	}
+	fmt.Println(Decode(value))
+
+	// Output: BNGH7C75FN
+}
+
+func TestEncode(t *testing.T) {
+	testCases := []struct {
+		USI           string
+		Expected      uint64
+		ExpectedError string
+	}{
+		{"2222222222", 0, ""},
+		{"2222222223", 1, ""},
+		{"ZZZZZZZZZZ", 1<<50 - 1, ""},
+		{"BNGH7C75F", 0, "key length must be 10 characters"},
+		{"BNGH7C75F0", 0, "invalid character in input"},
+		{"ſNGH7C75F", 0, "invalid character in input"}, // 10 bytes; strings.ToUpper would shorten it to 9
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.USI, func(t *testing.T) {
+			value, err := Encode(tc.USI)
+			if tc.ExpectedError != "" {
+				assert.EqualError(t, err, tc.ExpectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.Expected, value)
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	for _, usi := range []string{"BNGH7C75FN", "BP6LKB3C7X", "RVJ5DM8LXJ", "2222222222", "ZZZZZZZZZZ"} {
+		t.Run(usi, func(t *testing.T) {
+			value, err := Encode(usi)
+			assert.NoError(t, err)
+			assert.Equal(t, usi, Decode(value))
+		})
+	}
+
+	value, _ := Encode("bngh7c75fn")
+	assert.Equal(t, "BNGH7C75FN", Decode(value), "Lowercase input decodes to canonical form")
+	assert.Equal(t, "2222222223", Decode(1<<50|1), "High bits are ignored")
+}
+
+func TestEncodePreservesOrder(t *testing.T) {
+	usis := []string{"U6Q8JN6UD9", "BNGH7C75FN", "DG6K5YHPP3", "RVJ5DM8LXJ", "BP6LKB3C7X", "PDGGW5XLXW", "9ZZZZZZZZZ", "A222222222"}
+
+	values := make([]uint64, len(usis))
+	for i, usi := range usis {
+		values[i], _ = Encode(usi)
+	}
+
+	sort.Strings(usis)
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+	for i, value := range values {
+		assert.Equal(t, usis[i], Decode(value))
+	}
+}
@@ -0,0 +1,148 @@
+package usivalidator
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Sentinel errors returned, possibly wrapped, by the package. Use errors.Is to
+// test for them rather than matching error messages.
+var (
+	// ErrInvalidLength is reported for input that is not the expected length.
+	ErrInvalidLength = errors.New("invalid length")
+	// ErrInvalidCharacter is reported for input containing a character outside ValidCharacters.
+	ErrInvalidCharacter = errors.New("invalid character")
+	// ErrCheckCharMismatch is reported when a USI's check character is wrong. VerifyKey
+	// reports a mismatch as (false, nil) unless WithSuggestions wraps it in a
+	// *SuggestionError; ChecksumRule and VerifyKeys always report it.
+	ErrCheckCharMismatch = errors.New("check character mismatch")
+	// ErrLowercase is returned by VerifyKey with WithStrictCase for a USI not in uppercase.
+	ErrLowercase = errors.New("usi must be uppercase")
+	// ErrBlocked is returned by VerifyKey when a USI has a valid check character but appears in the blocklist.
+	ErrBlocked = errors.New("usi is blocklisted")
+	// ErrNotAllowed is returned by VerifyKey when a USI has a valid check character but is missing from the allowlist.
+	ErrNotAllowed = errors.New("usi is not in allowlist")
+	// ErrBannedPrefix is reported by BannedPrefixRule when a USI starts with a banned prefix.
+	ErrBannedPrefix = errors.New("usi has a banned prefix")
+	// ErrGeneratorExhausted is returned by Generator when its BlockedWords, or the number of distinct USIs requested, leave no USI to generate.
+	ErrGeneratorExhausted = errors.New("no usi left to generate")
+)
+
+// sentinelError is an error with its own message that matches a sentinel with errors.Is.
+type sentinelError struct {
+	sentinel error
+	msg      string
+}
+
+// newError returns an error with the formatted message that wraps sentinel.
+func newError(sentinel error, format string, args ...interface{}) error {
+	return &sentinelError{sentinel: sentinel, msg: fmt.Sprintf(format, args...)}
+}
+
+func (e *sentinelError) Error() string {
+	return e.msg
+}
+
+func (e *sentinelError) Unwrap() error {
+	return e.sentinel
+}
+
+// Reason classifies a ValidationError.
+type Reason int
+
+const (
+	// ReasonInvalidLength means the input is not the expected length.
+	ReasonInvalidLength Reason = iota + 1
+	// ReasonInvalidCharacter means the input contains a character outside the alphabet.
+	ReasonInvalidCharacter
+	// ReasonCheckCharMismatch means the check character is wrong.
+	ReasonCheckCharMismatch
+	// ReasonLowercase means a character is in lowercase and WithStrictCase is set.
+	ReasonLowercase
+)
+
+// String returns a short description of the reason.
+func (r Reason) String() string {
+	switch r {
+	case ReasonInvalidLength:
+		return "invalid length"
+	case ReasonInvalidCharacter:
+		return "invalid character"
+	case ReasonCheckCharMismatch:
+		return "check character mismatch"
+	case ReasonLowercase:
+		return "lowercase"
+	}
+	return "unknown"
+}
+
+// ValidationError describes why a USI or USI prefix is invalid, including the
+// offending character and its position so it can be pointed out to the
+// student. It matches ErrInvalidLength, ErrInvalidCharacter,
+// ErrCheckCharMismatch or ErrLowercase with errors.Is, according to Reason.
+type ValidationError struct {
+	Reason Reason
+	// Index is the zero-based character position of Char in the input, or -1
+	// for a length error.
+	Index int
+	// Char is the offending character, or 0 for a length error. VerifyKey
+	// reports it in uppercase.
+	Char rune
+	// Expected is the correct check character for a check character mismatch, otherwise 0.
+	Expected rune
+	// Length is the length of the input for a length error, otherwise 0.
+	Length int
+
+	msg string
+}
+
+// Error returns the error message.
+func (e *ValidationError) Error() string {
+	return e.msg
+}
+
+// Unwrap returns the sentinel error for Reason.
+func (e *ValidationError) Unwrap() error {
+	switch e.Reason {
+	case ReasonInvalidLength:
+		return ErrInvalidLength
+	case ReasonInvalidCharacter:
+		return ErrInvalidCharacter
+	case ReasonCheckCharMismatch:
+		return ErrCheckCharMismatch
+	case ReasonLowercase:
+		return ErrLowercase
+	}
+	return nil
+}
+
+// lengthError reports input of the wrong length.
+func lengthError(length int, format string, args ...interface{}) *ValidationError {
+	return &ValidationError{Reason: ReasonInvalidLength, Index: -1, Length: length, msg: fmt.Sprintf(format, args...)}
+}
+
+// characterError reports the first character of input missing from alphabet.
+func characterError(input string, alphabet []rune) *ValidationError {
+	e := &ValidationError{Reason: ReasonInvalidCharacter, Index: -1, msg: "invalid character in input"}
+	for i, char := range []rune(input) {
+		if indexOf(char, alphabet) == -1 {
+			e.Index, e.Char = i, char
+			break
+		}
+	}
+	return e
+}
+
+// mismatchError reports the wrong check character of a 10-character key whose
+// prefix is valid.
+func mismatchError(key string) *ValidationError {
+	key = upperASCII(key)
+	expected, _ := GenerateCheckCharacter(key[:9])
+	return &ValidationError{
+		Reason:   ReasonCheckCharMismatch,
+		Index:    9,
+		Char:     rune(key[9]),
+		Expected: expected,
+		msg:      ErrCheckCharMismatch.Error(),
+	}
+}
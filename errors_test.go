@@ -0,0 +1,86 @@
+package usivalidator
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSentinelErrors(t *testing.T) {
+	testCases := []struct {
+		Call     func() error
+		Sentinel error
+		Message  string
+		TestName string
+	}{
+		{func() error { _, err := VerifyKey("BNGH7C75F"); return err }, ErrInvalidLength, "key length must be 10 characters", "VerifyKey length"},
+		{func() error { _, err := VerifyKey("BNGH7C7OFN"); return err }, ErrInvalidCharacter, "invalid character in input", "VerifyKey character"},
+		{func() error { _, err := VerifyKey("BNGH7C7OFN", WithFastKernel()); return err }, ErrInvalidCharacter, "invalid character in input", "Fast kernel character"},
+		{func() error { _, err := GenerateCheckCharacter("BNGH7C75"); return err }, ErrInvalidLength, "input length must be 9 characters", "GenerateCheckCharacter length"},
+		{func() error { _, err := Encode("BNGH7C7OFN"); return err }, ErrInvalidCharacter, "invalid character in input", "Encode character"},
+		{func() error { _, err := Expand("BNGH7C7?"); return err }, ErrInvalidLength, "pattern length must be 10 characters", "Expand length"},
+		{func() error { _, err := VerifyFixed([]byte("BNGH")); return err }, ErrInvalidLength, "data length must be a multiple of 10", "VerifyFixed length"},
+		{func() error { _, err := ChecksumRule().Check("BNGH7C75FM"); return err }, ErrCheckCharMismatch, "check character mismatch", "Checksum rule mismatch"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			err := tc.Call()
+			assert.ErrorIs(t, err, tc.Sentinel)
+			assert.EqualError(t, err, tc.Message)
+		})
+	}
+}
+
+func ExampleVerifyKey_errorsIs() {
+	_, err := VerifyKey("BNGH7C7OFN")
+	switch {
+	case errors.Is(err, ErrInvalidLength):
+		fmt.Println("wrong length")
+	case errors.Is(err, ErrInvalidCharacter):
+		fmt.Println("invalid character")
+	}
+	// Output: invalid character
+}
+
+func TestValidationError(t *testing.T) {
+	testCases := []struct {
+		Call     func() error
+		Expected ValidationError
+		TestName string
+	}{
+		{func() error { _, err := VerifyKey("BNGH7C75F"); return err },
+			ValidationError{Reason: ReasonInvalidLength, Index: -1, Length: 9}, "VerifyKey length"},
+		{func() error { _, err := VerifyKey("bngh7c7ofn"); return err },
+			ValidationError{Reason: ReasonInvalidCharacter, Index: 7, Char: 'O'}, "VerifyKey character"},
+		{func() error { _, err := GenerateCheckCharacter("1NGH7C7OF"); return err },
+			ValidationError{Reason: ReasonInvalidCharacter, Index: 0, Char: '1'}, "First invalid character is reported"},
+		{func() error { _, err := Encode("BNGH7C75FI"); return err },
+			ValidationError{Reason: ReasonInvalidCharacter, Index: 9, Char: 'I'}, "Encode character"},
+		{func() error { _, err := ChecksumRule().Check("bngh7c75fm"); return err },
+			ValidationError{Reason: ReasonCheckCharMismatch, Index: 9, Char: 'M', Expected: 'N'}, "Checksum rule mismatch"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			var e *ValidationError
+			if assert.ErrorAs(t, tc.Call(), &e) {
+				assert.Equal(t, tc.Expected.Reason, e.Reason)
+				assert.Equal(t, tc.Expected.Index, e.Index)
+				assert.Equal(t, tc.Expected.Char, e.Char)
+				assert.Equal(t, tc.Expected.Expected, e.Expected)
+				assert.Equal(t, tc.Expected.Length, e.Length)
+			}
+		})
+	}
+}
+
+func TestReasonString(t *testing.T) {
+	assert.Equal(t, "invalid length", ReasonInvalidLength.String())
+	assert.Equal(t, "invalid character", ReasonInvalidCharacter.String())
+	assert.Equal(t, "check character mismatch", ReasonCheckCharMismatch.String())
+	assert.Equal(t, "lowercase", ReasonLowercase.String())
+	assert.Equal(t, "unknown", Reason(0).String())
+}
@@ -0,0 +1,88 @@
+package usivalidator
+
+import "fmt"
+
+// Wildcard marks an unknown character in a pattern passed to Expand.
+const Wildcard = '?'
+
+// MaxExpandPrefixes caps the number of candidate prefixes Expand will examine.
+// With 32 characters per wildcard this allows up to four wildcards in the
+// first nine characters; the check character position is free.
+const MaxExpandPrefixes = 1 << 20
+
+// Expand returns every checksum-valid USI matching pattern, in sorted order.
+// Each '?' in the pattern matches any valid character. This is intended for
+// partially legible identifiers, e.g. from scanned paper forms.
+//
+// Parameters:
+// - pattern (string): A 10-character pattern such as "BNGH7C7?F?". Case is ignored.
+//
+// Returns:
+// - ([]string): The matching valid USIs.
+// - (error): An error if the pattern is malformed or too broad to enumerate safely.
+//
+// Usage:
+// candidates, err := Expand("BNGH7C7?FN")
+// if err != nil {
+//     log.Println("Error:", err)
+// } else {
+//     fmt.Println(candidates) // [BNGH7C75FN]
+// }
+
+func Expand(pattern string) ([]string, error) {
+	if len(pattern) != 10 {
+		return nil, newError(ErrInvalidLength, "pattern length must be 10 characters")
+	}
+
+	pattern = upperASCII(pattern)
+	var wildcards []int
+	for i := 0; i < len(pattern); i++ {
+		if pattern[i] == Wildcard {
+			if i < 9 {
+				wildcards = append(wildcards, i)
+			}
+			continue
+		}
+		if indexOf(rune(pattern[i]), ValidCharacters) == -1 {
+			return nil, newError(ErrInvalidCharacter, "invalid character in pattern")
+		}
+	}
+
+	space := 1
+	for range wildcards {
+		space *= len(ValidCharacters)
+		if space > MaxExpandPrefixes {
+			return nil, fmt.Errorf("pattern has too many wildcards: more than %d candidates", MaxExpandPrefixes)
+		}
+	}
+
+	prefix := []byte(pattern[:9])
+	counters := make([]int, len(wildcards))
+	var results []string
+	for {
+		for i, pos := range wildcards {
+			prefix[pos] = byte(ValidCharacters[counters[i]])
+		}
+
+		checkChar, err := GenerateCheckCharacter(string(prefix))
+		if err != nil {
+			return nil, err
+		}
+		if pattern[9] == Wildcard || rune(pattern[9]) == checkChar {
+			results = append(results, string(prefix)+string(checkChar))
+		}
+
+		// Advance the rightmost wildcard first so results come out sorted.
+		i := len(counters) - 1
+		for ; i >= 0; i-- {
+			counters[i]++
+			if counters[i] < len(ValidCharacters) {
+				break
+			}
+			counters[i] = 0
+		}
+		if i < 0 {
+			return results, nil
+		}
+	}
+}
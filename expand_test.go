@@ -0,0 +1,61 @@
+package usivalidator
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func ExampleExpand() {
+	candidates, err := Expand("BNGH7C7?FN")
+	if err != nil {
+		fmt.Println("Error:", err)
+		return
+	}
+	fmt.Println(candidates)
+
+	// Output: [BNGH7C75FN]
+}
+
+func TestExpand(t *testing.T) {
+	testCases := []struct {
+		Pattern       string
+		Count         int
+		Contains      string
+		ExpectedError string
+	}{
+		{"BNGH7C75FN", 1, "BNGH7C75FN", ""},
+		{"BNGH7C75FX", 0, "", ""},
+		{"BNGH7C75F?", 1, "BNGH7C75FN", ""},
+		{"bngh7c7?f?", 32, "BNGH7C75FN", ""},
+		{"BNGH7C7?FN", 1, "BNGH7C75FN", ""},
+		{"?NGH7C7?FN", 32, "BNGH7C75FN", ""},
+		{"????7C75FN", 32 * 32 * 32, "BNGH7C75FN", ""},
+		{"BNGH7C7?F", 0, "", "pattern length must be 10 characters"},
+		{"BNGH7C7!F?", 0, "", "invalid character in pattern"},
+		{"?????C75FN", 0, "", "pattern has too many wildcards: more than 1048576 candidates"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Pattern, func(t *testing.T) {
+			results, err := Expand(tc.Pattern)
+			if tc.ExpectedError != "" {
+				assert.EqualError(t, err, tc.ExpectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, results, tc.Count)
+			if tc.Contains != "" {
+				assert.Contains(t, results, tc.Contains)
+			}
+			assert.True(t, sort.StringsAreSorted(results), "Results should be sorted")
+			for _, usi := range results {
+				isValid, err := VerifyKey(usi)
+				assert.NoError(t, err)
+				assert.True(t, isValid, usi)
+			}
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package usivalidator
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"strings"
+)
+
+// ExplainStep is the contribution of one prefix character to the Luhn Mod N sum.
+type ExplainStep struct {
+	// Position is the 1-based position of the character in the key.
+	Position int
+	// Char is the character, in uppercase.
+	Char rune
+	// CodePoint is the index of Char in ValidCharacters.
+	CodePoint int
+	// Factor is 2 for every second character counting from the right of the prefix, otherwise 1.
+	Factor int
+	// Product is CodePoint multiplied by Factor.
+	Product int
+	// Addend is the sum of the base-32 digits of Product.
+	Addend int
+}
+
+// Explanation is a step-by-step trace of the check character calculation.
+type Explanation struct {
+	// Key is the explained input, in uppercase.
+	Key string
+	// Steps holds one entry per prefix character, in key order.
+	Steps []ExplainStep
+	// Sum is the total of all addends.
+	Sum int
+	// Remainder is Sum modulo the alphabet size.
+	Remainder int
+	// CheckCodePoint is the code point of the check character.
+	CheckCodePoint int
+	// CheckChar is the calculated check character.
+	CheckChar rune
+	// Given is the check character supplied in Key, or 0 if only a prefix was explained.
+	Given rune
+}
+
+// Valid reports whether the supplied check character matches the calculated one.
+func (e *Explanation) Valid() bool {
+	return e.Given == e.CheckChar
+}
+
+// Explain traces the Luhn Mod N calculation for a USI or its 9-character
+// prefix, recording the intermediate values of every step.
+//
+// Parameters:
+// - key (string): A full USI or the 9 characters before its check character. Case is ignored.
+//
+// Returns:
+// - (*Explanation): The trace of the calculation.
+// - (error): An error if the length is not 9 or 10 or key contains invalid characters.
+//
+// Usage:
+// explanation, err := Explain("BNGH7C75FN")
+// fmt.Println(explanation.Markdown())
+
+func Explain(key string) (*Explanation, error) {
+	if len(key) != 9 && len(key) != 10 {
+		return nil, lengthError(len(key), "key length must be 9 or 10 characters")
+	}
+
+	key = upperASCII(key)
+	n := len(ValidCharacters)
+	e := &Explanation{Key: key, Steps: make([]ExplainStep, 9)}
+
+	factor := 2
+	for i := 8; i >= 0; i-- {
+		char := rune(key[i])
+		codePoint := indexOf(char, ValidCharacters)
+		if codePoint == -1 {
+			return nil, characterError(key, ValidCharacters)
+		}
+
+		product := factor * codePoint
+		addend := product/n + product%n
+		e.Steps[i] = ExplainStep{
+			Position:  i + 1,
+			Char:      char,
+			CodePoint: codePoint,
+			Factor:    factor,
+			Product:   product,
+			Addend:    addend,
+		}
+		e.Sum += addend
+		factor = alternateFactor(factor)
+	}
+
+	e.Remainder = e.Sum % n
+	e.CheckCodePoint = (n - e.Remainder) % n
+	e.CheckChar = ValidCharacters[e.CheckCodePoint]
+	if len(key) == 10 {
+		e.Given = rune(key[9])
+		if indexOf(e.Given, ValidCharacters) == -1 {
+			return nil, characterError(key, ValidCharacters)
+		}
+	}
+	return e, nil
+}
+
+// Markdown renders the explanation as a Markdown walkthrough suitable for
+// help articles and support replies.
+func (e *Explanation) Markdown() string {
+	n := len(ValidCharacters)
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "## How the check character of %s is calculated\n\n", e.Key)
+	fmt.Fprintf(&b, "Each of the first nine characters is replaced by its position (code point) in the USI alphabet `%s`, starting from 0. "+
+		"Working from the right, every second code point is doubled, starting with the ninth character. "+
+		"If a product is %d or more, its quotient and remainder when divided by %d are added together.\n\n", string(ValidCharacters), n, n)
+
+	b.WriteString("| Position | Character | Code point | Factor | Product | Addend |\n")
+	b.WriteString("|---:|:---:|---:|---:|---:|---:|\n")
+	for _, s := range e.Steps {
+		fmt.Fprintf(&b, "| %d | `%c` | %d | %d | %d | %d |\n", s.Position, s.Char, s.CodePoint, s.Factor, s.Product, s.Addend)
+	}
+
+	fmt.Fprintf(&b, "\nThe addends sum to **%d**, and %d mod %d = %d. ", e.Sum, e.Sum, n, e.Remainder)
+	fmt.Fprintf(&b, "The check code point is (%d − %d) mod %d = %d, which is the character **`%c`**.\n\n", n, e.Remainder, n, e.CheckCodePoint, e.CheckChar)
+	b.WriteString(e.conclusion("`") + "\n")
+	return b.String()
+}
+
+var explanationHTML = template.Must(template.New("explanation").Parse(`<section class="usi-explanation">
+<h2>How the check character of {{.Key}} is calculated</h2>
+<p>Each of the first nine characters is replaced by its position (code point) in the USI alphabet <code>{{.Alphabet}}</code>, starting from 0. Working from the right, every second code point is doubled, starting with the ninth character. If a product is {{.N}} or more, its quotient and remainder when divided by {{.N}} are added together.</p>
+<table>
+<thead><tr><th>Position</th><th>Character</th><th>Code point</th><th>Factor</th><th>Product</th><th>Addend</th></tr></thead>
+<tbody>
+{{- range .Steps}}
+<tr><td>{{.Position}}</td><td><code>{{printf "%c" .Char}}</code></td><td>{{.CodePoint}}</td><td>{{.Factor}}</td><td>{{.Product}}</td><td>{{.Addend}}</td></tr>
+{{- end}}
+</tbody>
+</table>
+<p>The addends sum to <strong>{{.Sum}}</strong>, and {{.Sum}} mod {{.N}} = {{.Remainder}}. The check code point is ({{.N}} − {{.Remainder}}) mod {{.N}} = {{.CheckCodePoint}}, which is the character <strong><code>{{printf "%c" .CheckChar}}</code></strong>.</p>
+<p>{{.Conclusion}}</p>
+</section>
+`))
+
+// HTML renders the explanation as an HTML fragment. All values are escaped.
+func (e *Explanation) HTML() string {
+	var b bytes.Buffer
+	err := explanationHTML.Execute(&b, struct {
+		*Explanation
+		Alphabet   string
+		N          int
+		Conclusion string
+	}{e, string(ValidCharacters), len(ValidCharacters), e.conclusion("")})
+	if err != nil {
+		// The template is fixed and its data is always well-formed.
+		panic(err)
+	}
+	return b.String()
+}
+
+// conclusion states the outcome, quoting characters with quote.
+func (e *Explanation) conclusion(quote string) string {
+	switch {
+	case e.Given == 0:
+		return fmt.Sprintf("The full USI is %s%c.", e.Key, e.CheckChar)
+	case e.Valid():
+		return fmt.Sprintf("The last character is %s%c%s, so %s is a valid USI.", quote, e.Given, quote, e.Key)
+	}
+	return fmt.Sprintf("The last character is %s%c%s but should be %s%c%s, so %s is not a valid USI.", quote, e.Given, quote, quote, e.CheckChar, quote, e.Key)
+}
@@ -0,0 +1,95 @@
+package usivalidator
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExplain(t *testing.T) {
+	testCases := []struct {
+		Key       string
+		CheckChar rune
+		Valid     bool
+		Error     string
+		TestName  string
+	}{
+		{"BNGH7C75FN", 'N', true, "", "Valid USI"},
+		{"bngh7c75fn", 'N', true, "", "Lowercase USI"},
+		{"BNGH7C75FM", 'N', false, "", "Wrong check character"},
+		{"BNGH7C75F", 'N', false, "", "Prefix only"},
+		{"BNGH7C75", 0, false, "key length must be 9 or 10 characters", "Too short"},
+		{"BNGH7C7OFN", 0, false, "invalid character in input", "Invalid character"},
+		{"BNGH7C75F1", 0, false, "invalid character in input", "Invalid check character"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			e, err := Explain(tc.Key)
+			if tc.Error != "" {
+				assert.EqualError(t, err, tc.Error)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.CheckChar, e.CheckChar)
+			assert.Equal(t, tc.Valid, e.Valid())
+
+			expected, _ := GenerateCheckCharacter(strings.ToUpper(tc.Key[:9]))
+			assert.Equal(t, expected, e.CheckChar, "Trace should agree with GenerateCheckCharacter")
+		})
+	}
+}
+
+func TestExplainSteps(t *testing.T) {
+	e, err := Explain("BNGH7C75FN")
+	assert.NoError(t, err)
+	assert.Len(t, e.Steps, 9)
+
+	sum := 0
+	for i, s := range e.Steps {
+		assert.Equal(t, i+1, s.Position)
+		assert.Equal(t, ValidCharacters[s.CodePoint], s.Char)
+		assert.Equal(t, s.CodePoint*s.Factor, s.Product)
+		sum += s.Addend
+	}
+	assert.Equal(t, 2, e.Steps[8].Factor, "Rightmost prefix character should be doubled")
+	assert.Equal(t, 1, e.Steps[7].Factor)
+	assert.Equal(t, sum, e.Sum)
+}
+
+func TestExplanationMarkdown(t *testing.T) {
+	e, _ := Explain("BNGH7C75FM")
+	markdown := e.Markdown()
+	assert.Contains(t, markdown, "## How the check character of BNGH7C75FM is calculated")
+	assert.Contains(t, markdown, "| 1 | `B` | 9 | 2 | 18 | 18 |")
+	assert.Contains(t, markdown, "The last character is `M` but should be `N`, so BNGH7C75FM is not a valid USI.")
+}
+
+func TestExplanationHTML(t *testing.T) {
+	e, _ := Explain("BNGH7C75F")
+	html := e.HTML()
+	assert.True(t, strings.HasPrefix(html, `<section class="usi-explanation">`))
+	assert.Contains(t, html, "<tr><td>1</td><td><code>B</code></td><td>9</td><td>2</td><td>18</td><td>18</td></tr>")
+	assert.Contains(t, html, "The full USI is BNGH7C75FN.")
+}
+
+func ExampleExplain() {
+	e, _ := Explain("BNGH7C75FN")
+	for _, s := range e.Steps {
+		fmt.Printf("%c: %d x %d -> %d\n", s.Char, s.CodePoint, s.Factor, s.Addend)
+	}
+	fmt.Printf("sum %d, check character %c\n", e.Sum, e.CheckChar)
+	// Output:
+	// B: 9 x 2 -> 18
+	// N: 20 x 1 -> 20
+	// G: 14 x 2 -> 28
+	// H: 15 x 1 -> 15
+	// 7: 5 x 2 -> 10
+	// C: 10 x 1 -> 10
+	// 7: 5 x 2 -> 10
+	// 5: 3 x 1 -> 3
+	// F: 13 x 2 -> 26
+	// sum 140, check character N
+}
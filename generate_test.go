@@ -0,0 +1,164 @@
+package usivalidator
+
+import (
+	"math/rand/v2"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateRandomUSI(t *testing.T) {
+	seen := make(map[string]bool)
+	for i := 0; i < 1000; i++ {
+		usi := GenerateRandomUSI()
+		isValid, err := VerifyKey(usi, WithStrictCase())
+		assert.NoError(t, err)
+		assert.True(t, isValid, usi)
+		seen[usi] = true
+	}
+	assert.Greater(t, len(seen), 990, "Generated USIs should be random")
+}
+
+func TestGenerateRandomUSIs(t *testing.T) {
+	testCases := []struct {
+		N        int
+		Expected int
+		TestName string
+	}{
+		{5000, 5000, "Many USIs"},
+		{1, 1, "One USI"},
+		{0, 0, "Zero"},
+		{-1, 0, "Negative"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			usis := GenerateRandomUSIs(tc.N)
+			assert.Len(t, usis, tc.Expected)
+
+			seen := make(map[string]bool)
+			for _, usi := range usis {
+				assert.False(t, seen[usi], "Duplicate USI %s", usi)
+				seen[usi] = true
+				isValid, _ := VerifyKey(usi)
+				assert.True(t, isValid, usi)
+			}
+		})
+	}
+}
+
+func TestRandomUSIsDeduplicates(t *testing.T) {
+	// Each USI drawn from this source is produced twice in a row.
+	draws := 0
+	intN := func(n int) int {
+		usi := draws / 9
+		draws++
+		return (usi / 2) % n
+	}
+
+	usis, err := randomUSIs(3, func() (string, error) { return randomUSI(intN), nil })
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"222222222" + string(mustCheckChar("222222222")), "333333333" + string(mustCheckChar("333333333")), "444444444" + string(mustCheckChar("444444444"))}, usis)
+	assert.Equal(t, 9*5, draws, "Duplicates should be drawn again")
+}
+
+func TestRandomUSIsExhausted(t *testing.T) {
+	usis, err := randomUSIs(2, func() (string, error) { return "BNGH7C75FN", nil })
+	assert.Nil(t, usis)
+	assert.ErrorIs(t, err, ErrGeneratorExhausted)
+	assert.EqualError(t, err, "only 1 distinct usis could be generated, 2 requested")
+}
+
+// mustUSI returns the next USI from g, failing the test on error.
+func mustUSI(t *testing.T, g *Generator) string {
+	t.Helper()
+	usi, err := g.USI()
+	assert.NoError(t, err)
+	return usi
+}
+
+// mustUSIs returns the next n USIs from g, failing the test on error.
+func mustUSIs(t *testing.T, g *Generator, n int) []string {
+	t.Helper()
+	usis, err := g.USIs(n)
+	assert.NoError(t, err)
+	return usis
+}
+
+func TestSeededGenerator(t *testing.T) {
+	generator := NewSeededGenerator(513)
+	assert.Equal(t, "G5YKU5T2UM", mustUSI(t, generator))
+	assert.Equal(t, "4ERR7LKAZ6", mustUSI(t, generator))
+	assert.Equal(t, []string{"XL8N8K5H9M", "TF5PCREB8F"}, mustUSIs(t, generator, 2))
+
+	assert.Equal(t, mustUSIs(t, NewSeededGenerator(7), 100), mustUSIs(t, NewSeededGenerator(7), 100), "Same seed should give the same sequence")
+	assert.NotEqual(t, mustUSI(t, NewSeededGenerator(7)), mustUSI(t, NewSeededGenerator(8)))
+}
+
+func TestNewGenerator(t *testing.T) {
+	a := NewGenerator(rand.New(rand.NewPCG(1, 2)))
+	b := NewGenerator(rand.New(rand.NewPCG(1, 2)))
+	for i := 0; i < 100; i++ {
+		usi := mustUSI(t, a)
+		assert.Equal(t, usi, mustUSI(t, b))
+		isValid, _ := VerifyKey(usi)
+		assert.True(t, isValid, usi)
+	}
+}
+
+func TestSecureGenerator(t *testing.T) {
+	usis := mustUSIs(t, NewSecureGenerator(), 1000)
+	assert.Len(t, usis, 1000)
+	for _, usi := range usis {
+		isValid, _ := VerifyKey(usi)
+		assert.True(t, isValid, usi)
+	}
+	assert.NotEqual(t, mustUSI(t, NewSecureGenerator()), mustUSI(t, NewSecureGenerator()))
+}
+
+func TestGeneratorBlockedWords(t *testing.T) {
+	generator := NewSeededGenerator(515)
+	generator.BlockedWords = []string{"a", "b", "c", "d", "e", "f", "g", "h", "", "22"}
+
+	for _, usi := range mustUSIs(t, generator, 500) {
+		isValid, _ := VerifyKey(usi)
+		assert.True(t, isValid, usi)
+		assert.NotRegexp(t, "[A-H]|22", usi)
+	}
+}
+
+func TestGeneratorBlockedWordsExhausted(t *testing.T) {
+	var everyChar, allButA []string
+	for _, char := range ValidCharacters {
+		everyChar = append(everyChar, strings.ToLower(string(char)))
+		if char != 'A' {
+			allButA = append(allButA, string(char))
+		}
+	}
+
+	tests := []struct {
+		TestName     string
+		BlockedWords []string
+		Expected     string
+	}{
+		{"every character", everyChar, "blocked words rule out every character"},
+		{"all but one character", allButA, "10000 candidates in a row contained blocked words"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.TestName, func(t *testing.T) {
+			generator := NewSeededGenerator(1)
+			generator.BlockedWords = tt.BlockedWords
+
+			usi, err := generator.USI()
+			assert.Empty(t, usi)
+			assert.ErrorIs(t, err, ErrGeneratorExhausted)
+			assert.EqualError(t, err, tt.Expected)
+
+			usis, err := generator.USIs(3)
+			assert.Nil(t, usis)
+			assert.ErrorIs(t, err, ErrGeneratorExhausted)
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package usivalidator
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"runtime/debug"
+)
+
+// modulePath is the import path used to find the package version in the build info.
+const modulePath = "github.com/chrisjoyce911/usivalidator"
+
+// Info documents the validation rules in force, for diagnostics endpoints and
+// audit records.
+type Info struct {
+	// Scheme is the name of the identifier scheme, e.g. "USI v1".
+	Scheme string `json:"scheme"`
+	// Alphabet lists the valid characters in code point order.
+	Alphabet string `json:"alphabet"`
+	// Length is the full key length, including the check character.
+	Length int `json:"length"`
+	// Algorithm identifies the check character algorithm.
+	Algorithm string `json:"algorithm"`
+	// Version is the module version of this package, or "(devel)" if unknown.
+	Version string `json:"version"`
+	// ConformanceSHA256 is the hex-encoded SHA-256 of the embedded conformance vectors.
+	ConformanceSHA256 string `json:"conformance_sha256"`
+}
+
+// AlgorithmInfo describes the scheme used by VerifyKey and the package build
+// that provides it.
+//
+// Returns:
+// - (Info): The scheme, package version and conformance vector hash.
+//
+// Usage:
+// info := AlgorithmInfo()
+// json.NewEncoder(w).Encode(info)
+
+func AlgorithmInfo() Info {
+	sum := sha256.Sum256(conformanceVectors)
+	return Info{
+		Scheme:            USIv1.Name,
+		Alphabet:          string(USIv1.Alphabet),
+		Length:            USIv1.Length,
+		Algorithm:         USIv1.Algorithm,
+		Version:           moduleVersion(),
+		ConformanceSHA256: hex.EncodeToString(sum[:]),
+	}
+}
+
+// moduleVersion returns the version of this module recorded in the build info.
+func moduleVersion() string {
+	build, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "(devel)"
+	}
+	if build.Main.Path == modulePath && build.Main.Version != "" {
+		return build.Main.Version
+	}
+	for _, dep := range build.Deps {
+		if dep.Path == modulePath {
+			if dep.Replace != nil && dep.Replace.Version != "" {
+				return dep.Replace.Version
+			}
+			return dep.Version
+		}
+	}
+	return "(devel)"
+}
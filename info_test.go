@@ -0,0 +1,33 @@
+package usivalidator
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAlgorithmInfo(t *testing.T) {
+	info := AlgorithmInfo()
+	sum := sha256.Sum256(conformanceVectors)
+
+	assert.Equal(t, "USI v1", info.Scheme)
+	assert.Equal(t, canonicalAlphabet, info.Alphabet)
+	assert.Equal(t, 10, info.Length)
+	assert.Equal(t, "luhn-mod-n", info.Algorithm)
+	assert.NotEmpty(t, info.Version)
+	assert.Equal(t, hex.EncodeToString(sum[:]), info.ConformanceSHA256)
+}
+
+func TestAlgorithmInfoJSON(t *testing.T) {
+	data, err := json.Marshal(AlgorithmInfo())
+	assert.NoError(t, err)
+
+	var fields map[string]interface{}
+	assert.NoError(t, json.Unmarshal(data, &fields))
+	for _, key := range []string{"scheme", "alphabet", "length", "algorithm", "version", "conformance_sha256"} {
+		assert.Contains(t, fields, key)
+	}
+}
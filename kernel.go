@@ -0,0 +1,124 @@
+package usivalidator
+
+import "unicode"
+
+// kernelInvalid is added to a lookup for any byte outside the alphabet. It is
+// far larger than any valid sum, so a single high-bit test on the accumulated
+// sum detects every invalid character without a branch per byte.
+const kernelInvalid = 1 << 16
+
+// Lookup tables for the fast kernel, indexed by input byte. Lower and upper
+// case letters share entries, so no case conversion is needed.
+var (
+	// kernelSingle holds the addend for a position with factor 1 (the code point).
+	kernelSingle [256]uint32
+	// kernelDouble holds the addend for a position with factor 2.
+	kernelDouble [256]uint32
+	// kernelCheck maps a prefix sum to the code point of its check character.
+	kernelCheck [9 * 32]uint32
+)
+
+func init() {
+	buildKernelTables()
+}
+
+func buildKernelTables() {
+	n := len(ValidCharacters)
+	for b := 0; b < 256; b++ {
+		codePoint := indexOf(unicode.ToUpper(rune(b)), ValidCharacters)
+		if b >= 0x80 || codePoint == -1 {
+			kernelSingle[b] = kernelInvalid
+			kernelDouble[b] = kernelInvalid
+			continue
+		}
+		doubled := 2 * codePoint
+		kernelSingle[b] = uint32(codePoint)
+		kernelDouble[b] = uint32(doubled/n + doubled%n)
+	}
+	for sum := range kernelCheck {
+		kernelCheck[sum] = uint32((n - sum%n) % n)
+	}
+}
+
+// kernelVerify checks a 10-byte key using only table lookups and additions.
+// wellFormed is false if any byte is outside the alphabet, in which case valid
+// is meaningless. The caller must ensure len(key) == 10.
+func kernelVerify(key string) (valid, wellFormed bool) {
+	_ = key[9]
+	sum := kernelDouble[key[8]] + kernelSingle[key[7]] +
+		kernelDouble[key[6]] + kernelSingle[key[5]] +
+		kernelDouble[key[4]] + kernelSingle[key[3]] +
+		kernelDouble[key[2]] + kernelSingle[key[1]] +
+		kernelDouble[key[0]]
+	check := kernelSingle[key[9]]
+
+	if (sum|check)&^(kernelInvalid-1) != 0 {
+		return false, false
+	}
+	return kernelCheck[sum] == check, true
+}
+
+// WithFastKernel makes VerifyKey use a branch-minimal, table-driven kernel for
+// well-formed input. Malformed input falls back to the standard path so that
+// errors are reported exactly as without the option.
+//
+// Returns:
+// - (Option): The option to pass to VerifyKey.
+//
+// Usage:
+// verifier := NewVerifier(WithFastKernel())
+
+func WithFastKernel() Option {
+	return func(o *options) {
+		o.fastKernel = true
+	}
+}
+
+// VerifyFixed validates a buffer of fixed-width 10-byte records, such as a
+// file of USIs without separators or a column extracted from a fixed-width
+// export, using the fast kernel. Records containing invalid characters are
+// reported as not valid.
+//
+// Parameters:
+// - data ([]byte): Concatenated 10-byte records.
+//
+// Returns:
+// - ([]bool): The validity of each record, in order.
+// - (error): An error if len(data) is not a multiple of 10.
+//
+// Usage:
+// results, err := VerifyFixed([]byte("BNGH7C75FNBP6LKB3C7X"))
+
+func VerifyFixed(data []byte) ([]bool, error) {
+	if len(data)%10 != 0 {
+		return nil, newError(ErrInvalidLength, "data length must be a multiple of 10")
+	}
+
+	results := make([]bool, len(data)/10)
+	for i := range results {
+		valid, _ := kernelVerify(string(data[i*10 : i*10+10]))
+		results[i] = valid
+	}
+	return results, nil
+}
+
+// kernelIntact reports whether the fast kernel tables agree with ValidCharacters.
+func kernelIntact() bool {
+	n := len(ValidCharacters)
+	for b := 0; b < 256; b++ {
+		codePoint := -1
+		if b < 0x80 {
+			codePoint = indexOf(unicode.ToUpper(rune(b)), ValidCharacters)
+		}
+		if codePoint == -1 {
+			if kernelSingle[b] != kernelInvalid || kernelDouble[b] != kernelInvalid {
+				return false
+			}
+			continue
+		}
+		if kernelSingle[b] != uint32(codePoint) || kernelDouble[b] != uint32(2*codePoint/n+2*codePoint%n) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,79 @@
+package usivalidator
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFastKernelAgreement(t *testing.T) {
+	keys := referenceCorpus(20000)
+	for _, key := range keys {
+		keys = append(keys, strings.ToLower(key))
+	}
+
+	for _, key := range keys {
+		expectedValid, expectedErr := VerifyKey(key)
+		isValid, err := VerifyKey(key, WithFastKernel())
+		assert.Equal(t, expectedValid, isValid, key)
+		assert.Equal(t, expectedErr, err, key)
+	}
+}
+
+func TestFastKernelWithOptions(t *testing.T) {
+	blocklist := NewBlockset(1, 0)
+	blocklist.Add("BNGH7C75FN")
+
+	_, err := VerifyKey("bngh7c75fn", WithFastKernel(), WithBlocklist(blocklist))
+	assert.ErrorIs(t, err, ErrBlocked)
+
+	_, err = VerifyKey("BNGH7C75FM", WithFastKernel(), WithSuggestions())
+	var suggestion *SuggestionError
+	assert.ErrorAs(t, err, &suggestion)
+}
+
+func TestVerifyFixed(t *testing.T) {
+	testCases := []struct {
+		Data     string
+		Expected []bool
+		Error    string
+		TestName string
+	}{
+		{"BNGH7C75FNBNGH7C75FM", []bool{true, false}, "", "Valid and invalid records"},
+		{"bngh7c75fnBNGH7C7OFN", []bool{true, false}, "", "Lowercase and malformed records"},
+		{"", []bool{}, "", "Empty buffer"},
+		{"BNGH7C75F", nil, "data length must be a multiple of 10", "Partial record"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			results, err := VerifyFixed([]byte(tc.Data))
+			if tc.Error != "" {
+				assert.EqualError(t, err, tc.Error)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.Expected, results)
+		})
+	}
+}
+
+func BenchmarkVerifyKeyFastKernel(b *testing.B) {
+	keys := referenceCorpus(1024)
+	fast := WithFastKernel()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		VerifyKey(keys[i%len(keys)], fast)
+	}
+	b.ReportMetric(float64(b.N)/b.Elapsed().Seconds(), "keys/s")
+}
+
+func BenchmarkVerifyFixed(b *testing.B) {
+	data := []byte(strings.Join(referenceCorpus(1024), "")[:10240])
+	b.SetBytes(int64(len(data)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		VerifyFixed(data)
+	}
+}
@@ -0,0 +1,92 @@
+package usivalidator
+
+import "strconv"
+
+// CachedVerifier memoizes the results of another Verifier. Results are kept
+// until evicted from the cache, so it should only wrap verifiers whose answer
+// for a key does not change.
+//
+// Only error-free results are cached, stored as a plain bool so that caches
+// which serialize values (Redis, memcached, ...) work. Such a cache may return
+// the value as a bool, or as a string or []byte accepted by strconv.ParseBool.
+// Errors are not cached, since they cannot be restored faithfully from an
+// external store; the wrapped verifier is called again for those keys.
+type CachedVerifier struct {
+	// Metrics, if set, receives a MetricCacheHit or MetricCacheMiss count for
+	// every lookup.
+	Metrics MetricsSink
+
+	verifier Verifier
+	cache    Cache
+}
+
+// NewCachedVerifier wraps v with an LRU cache of the given size, so repeated
+// verification of the same key skips recomputation.
+//
+// Parameters:
+// - v (Verifier): The verifier to memoize.
+// - size (int): The maximum number of results to remember.
+//
+// Returns:
+// - (*CachedVerifier): The memoizing verifier.
+//
+// Usage:
+// verifier := NewCachedVerifier(DefaultVerifier, 10000)
+// isValid, err := verifier.VerifyKey("BNGH7C75FN")
+
+func NewCachedVerifier(v Verifier, size int) *CachedVerifier {
+	return NewCachedVerifierWithCache(v, NewLRUCache(size))
+}
+
+// NewCachedVerifierWithCache wraps v with the supplied cache, for example a
+// shared or external store.
+//
+// Parameters:
+// - v (Verifier): The verifier to memoize.
+// - cache (Cache): Where results are stored. Entries are set without a TTL.
+//
+// Returns:
+// - (*CachedVerifier): The memoizing verifier.
+
+func NewCachedVerifierWithCache(v Verifier, cache Cache) *CachedVerifier {
+	return &CachedVerifier{verifier: v, cache: cache}
+}
+
+// VerifyKey returns the cached result for key, calling the wrapped verifier on a miss.
+func (c *CachedVerifier) VerifyKey(key string) (bool, error) {
+	if value, ok := c.cache.Get(key); ok {
+		if valid, ok := cachedValid(value); ok {
+			c.count(MetricCacheHit)
+			return valid, nil
+		}
+	}
+
+	c.count(MetricCacheMiss)
+	valid, err := c.verifier.VerifyKey(key)
+	if err == nil {
+		c.cache.Set(key, valid, 0)
+	}
+	return valid, err
+}
+
+// cachedValid decodes a value stored by VerifyKey, possibly after a round trip
+// through an external store.
+func cachedValid(value interface{}) (bool, bool) {
+	switch v := value.(type) {
+	case bool:
+		return v, true
+	case string:
+		valid, err := strconv.ParseBool(v)
+		return valid, err == nil
+	case []byte:
+		valid, err := strconv.ParseBool(string(v))
+		return valid, err == nil
+	}
+	return false, false
+}
+
+func (c *CachedVerifier) count(name string) {
+	if c.Metrics != nil {
+		c.Metrics.IncCounter(name, 1)
+	}
+}
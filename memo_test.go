@@ -0,0 +1,91 @@
+package usivalidator
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/chrisjoyce911/usivalidator/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCachedVerifier(t *testing.T) {
+	errBoom := errors.New("boom")
+	v := mocks.NewVerifier(t)
+	v.EXPECT().VerifyKey("BNGH7C75FN").Return(true, nil).Once()
+	v.EXPECT().VerifyKey("BNGH7C75FM").Return(false, nil).Once()
+	v.EXPECT().VerifyKey("BAD").Return(false, errBoom).Times(3)
+
+	cached := NewCachedVerifier(v, 10)
+	for i := 0; i < 3; i++ {
+		isValid, err := cached.VerifyKey("BNGH7C75FN")
+		assert.True(t, isValid)
+		assert.NoError(t, err)
+
+		isValid, err = cached.VerifyKey("BNGH7C75FM")
+		assert.False(t, isValid)
+		assert.NoError(t, err)
+
+		// Errors are not cached.
+		isValid, err = cached.VerifyKey("BAD")
+		assert.False(t, isValid)
+		assert.Equal(t, errBoom, err)
+	}
+}
+
+func TestCachedVerifierEviction(t *testing.T) {
+	v := mocks.NewVerifier(t)
+	v.EXPECT().VerifyKey("BNGH7C75FN").Return(true, nil).Twice()
+	v.EXPECT().VerifyKey("BP6LKB3C7X").Return(true, nil).Once()
+
+	cached := NewCachedVerifier(v, 1)
+	cached.VerifyKey("BNGH7C75FN")
+	cached.VerifyKey("BP6LKB3C7X")
+	cached.VerifyKey("BNGH7C75FN")
+}
+
+func TestCachedVerifierWithNopCache(t *testing.T) {
+	v := mocks.NewVerifier(t)
+	v.EXPECT().VerifyKey("BNGH7C75FN").Return(true, nil).Twice()
+
+	cached := NewCachedVerifierWithCache(v, NopCache{})
+	cached.VerifyKey("BNGH7C75FN")
+	cached.VerifyKey("BNGH7C75FN")
+}
+
+// serializingCache stores values as strings, like an external store would.
+type serializingCache struct {
+	entries map[string]string
+}
+
+func (c *serializingCache) Get(key string) (interface{}, bool) {
+	value, ok := c.entries[key]
+	return []byte(value), ok
+}
+
+func (c *serializingCache) Set(key string, value interface{}, ttl time.Duration) {
+	c.entries[key] = fmt.Sprint(value)
+}
+
+func TestCachedVerifierWithSerializingCache(t *testing.T) {
+	v := mocks.NewVerifier(t)
+	v.EXPECT().VerifyKey("BNGH7C75FN").Return(true, nil).Once()
+	v.EXPECT().VerifyKey("BNGH7C75FM").Return(false, nil).Once()
+
+	sink := newRecordingSink()
+	cached := NewCachedVerifierWithCache(v, &serializingCache{entries: make(map[string]string)})
+	cached.Metrics = sink
+	for i := 0; i < 2; i++ {
+		isValid, err := cached.VerifyKey("BNGH7C75FN")
+		assert.True(t, isValid)
+		assert.NoError(t, err)
+
+		isValid, err = cached.VerifyKey("BNGH7C75FM")
+		assert.False(t, isValid)
+		assert.NoError(t, err)
+	}
+
+	assert.Equal(t, int64(2), sink.counters[MetricCacheHit], "Round-tripped values should be cache hits")
+	assert.Equal(t, int64(2), sink.counters[MetricCacheMiss])
+}
@@ -0,0 +1,74 @@
+package usivalidator
+
+import "time"
+
+// Metric names reported by instrumented components.
+const (
+	MetricVerify         = "usi.verify"
+	MetricVerifyDuration = "usi.verify.duration"
+	MetricCacheHit       = "usi.cache.hit"
+	MetricCacheMiss      = "usi.cache.miss"
+)
+
+// MetricsSink receives measurements from instrumented components, so the
+// package does not depend on a particular metrics stack. Tags are "key:value"
+// strings. Implementations must be safe for concurrent use.
+type MetricsSink interface {
+	// IncCounter adds value to the named counter.
+	IncCounter(name string, value int64, tags ...string)
+	// ObserveDuration records a timing for the named measurement.
+	ObserveDuration(name string, d time.Duration, tags ...string)
+}
+
+// NopMetrics is a MetricsSink that discards everything.
+type NopMetrics struct{}
+
+// IncCounter does nothing.
+func (NopMetrics) IncCounter(name string, value int64, tags ...string) {}
+
+// ObserveDuration does nothing.
+func (NopMetrics) ObserveDuration(name string, d time.Duration, tags ...string) {}
+
+// InstrumentedVerifier reports each verification to a MetricsSink: a
+// MetricVerify count tagged "result:valid", "result:invalid" or
+// "result:error", and its MetricVerifyDuration.
+type InstrumentedVerifier struct {
+	verifier Verifier
+	sink     MetricsSink
+	now      func() time.Time
+}
+
+// NewInstrumentedVerifier wraps v so every call is reported to sink.
+//
+// Parameters:
+// - v (Verifier): The verifier to instrument.
+// - sink (MetricsSink): Where measurements are sent.
+//
+// Returns:
+// - (*InstrumentedVerifier): The instrumented verifier.
+//
+// Usage:
+// verifier := NewInstrumentedVerifier(DefaultVerifier, sink)
+// isValid, err := verifier.VerifyKey("BNGH7C75FN")
+
+func NewInstrumentedVerifier(v Verifier, sink MetricsSink) *InstrumentedVerifier {
+	return &InstrumentedVerifier{verifier: v, sink: sink, now: time.Now}
+}
+
+// VerifyKey calls the wrapped verifier and reports the outcome.
+func (v *InstrumentedVerifier) VerifyKey(key string) (bool, error) {
+	start := v.now()
+	valid, err := v.verifier.VerifyKey(key)
+	elapsed := v.now().Sub(start)
+
+	result := "result:invalid"
+	switch {
+	case err != nil:
+		result = "result:error"
+	case valid:
+		result = "result:valid"
+	}
+	v.sink.IncCounter(MetricVerify, 1, result)
+	v.sink.ObserveDuration(MetricVerifyDuration, elapsed, result)
+	return valid, err
+}
@@ -0,0 +1,73 @@
+package usivalidator
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var _ MetricsSink = NopMetrics{}
+
+// recordingSink is a MetricsSink that keeps every measurement for inspection.
+type recordingSink struct {
+	mu        sync.Mutex
+	counters  map[string]int64
+	durations []time.Duration
+}
+
+func newRecordingSink() *recordingSink {
+	return &recordingSink{counters: make(map[string]int64)}
+}
+
+func (s *recordingSink) IncCounter(name string, value int64, tags ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := name
+	for _, tag := range tags {
+		key += "," + tag
+	}
+	s.counters[key] += value
+}
+
+func (s *recordingSink) ObserveDuration(name string, d time.Duration, tags ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.durations = append(s.durations, d)
+}
+
+func TestInstrumentedVerifier(t *testing.T) {
+	sink := newRecordingSink()
+	v := NewInstrumentedVerifier(DefaultVerifier, sink)
+
+	tick := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	v.now = func() time.Time {
+		tick = tick.Add(time.Millisecond)
+		return tick
+	}
+
+	v.VerifyKey("BNGH7C75FN")
+	v.VerifyKey("BP6LKB3C7X")
+	v.VerifyKey("BNGH7C75FX")
+	v.VerifyKey("SHORT")
+
+	assert.Equal(t, map[string]int64{
+		"usi.verify,result:valid":   2,
+		"usi.verify,result:invalid": 1,
+		"usi.verify,result:error":   1,
+	}, sink.counters)
+	assert.Equal(t, []time.Duration{time.Millisecond, time.Millisecond, time.Millisecond, time.Millisecond}, sink.durations)
+}
+
+func TestCachedVerifierMetrics(t *testing.T) {
+	sink := newRecordingSink()
+	cached := NewCachedVerifier(DefaultVerifier, 10)
+	cached.Metrics = sink
+
+	cached.VerifyKey("BNGH7C75FN")
+	cached.VerifyKey("BNGH7C75FN")
+	cached.VerifyKey("BNGH7C75FN")
+
+	assert.Equal(t, map[string]int64{"usi.cache.miss": 1, "usi.cache.hit": 2}, sink.counters)
+}
@@ -0,0 +1,76 @@
+package usivalidator
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Normalize cleans up a USI as typed or pasted, e.g. from an email: it removes
+// whitespace (including non-breaking spaces) and dashes anywhere in the input
+// and converts it to uppercase. The check character is not verified.
+//
+// Parameters:
+// - input (string): The USI as entered, such as " bngh-7c75 fn ".
+//
+// Returns:
+// - (string): The normalized USI.
+// - (error): A *ValidationError if the result is not 10 characters from ValidCharacters.
+//
+// Usage:
+// usi, err := Normalize(" bngh-7c75 fn ")
+// if err == nil {
+//     fmt.Println(usi) // BNGH7C75FN
+// }
+
+func Normalize(input string) (string, error) {
+	normalized := upperASCII(StripSeparators(input))
+
+	chars := []rune(normalized)
+	for i, char := range chars {
+		if indexOf(char, ValidCharacters) == -1 {
+			return "", &ValidationError{Reason: ReasonInvalidCharacter, Index: i, Char: char, msg: "invalid character in input"}
+		}
+	}
+	if len(chars) != 10 {
+		return "", lengthError(len(chars), "key length must be 10 characters")
+	}
+	return normalized, nil
+}
+
+// StripSeparators removes whitespace (including non-breaking spaces) and
+// dashes from input, as done by Normalize and WithLenient. Case and all other
+// characters are left unchanged.
+//
+// Parameters:
+// - input (string): The text to clean.
+//
+// Returns:
+// - (string): input without separators.
+//
+// Usage:
+// cleaned := StripSeparators(" BNGH-7C75 FN ") // "BNGH7C75FN"
+
+func StripSeparators(input string) string {
+	return strings.Map(func(char rune) rune {
+		if unicode.IsSpace(char) || unicode.Is(unicode.Pd, char) {
+			return -1
+		}
+		return char
+	}, input)
+}
+
+// WithLenient makes VerifyKey tolerate surrounding whitespace and spaces or
+// dashes within the USI, as Normalize does, before checking it. Positions in
+// a *ValidationError then refer to the USI with those characters removed.
+//
+// Returns:
+// - (Option): The option to pass to VerifyKey.
+//
+// Usage:
+// isValid, err := VerifyKey(" BNGH-7C75-FN ", WithLenient())
+
+func WithLenient() Option {
+	return func(o *options) {
+		o.lenient = true
+	}
+}
@@ -0,0 +1,86 @@
+package usivalidator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize(t *testing.T) {
+	testCases := []struct {
+		Input    string
+		Expected string
+		Error    error
+		TestName string
+	}{
+		{"BNGH7C75FN", "BNGH7C75FN", nil, "Already normalized"},
+		{"  bngh7c75fn\n", "BNGH7C75FN", nil, "Surrounding whitespace and lowercase"},
+		{"BNGH-7C75-FN", "BNGH7C75FN", nil, "Hyphens"},
+		{"BNGH 7C75 FN", "BNGH7C75FN", nil, "Spaces"},
+		{"BNGH\u00a07C75\u2013FN", "BNGH7C75FN", nil, "Non-breaking space and en dash"},
+		{"BNGH7C75FM", "BNGH7C75FM", nil, "Check character is not verified"},
+		{"BNGH.7C75FN", "", ErrInvalidCharacter, "Other punctuation"},
+		{"ſNGH7C75FN", "", ErrInvalidCharacter, "Non-ASCII letter that uppercases to ASCII"},
+		{"BNGH 7C75", "", ErrInvalidLength, "Too short"},
+		{"", "", ErrInvalidLength, "Empty"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			normalized, err := Normalize(tc.Input)
+			assert.Equal(t, tc.Expected, normalized)
+			if tc.Error != nil {
+				assert.ErrorIs(t, err, tc.Error)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNormalizeReportsPosition(t *testing.T) {
+	_, err := Normalize("BNGH-7C7O-FN")
+	var e *ValidationError
+	if assert.ErrorAs(t, err, &e) {
+		assert.Equal(t, 7, e.Index, "Index should refer to the normalized USI")
+		assert.Equal(t, 'O', e.Char)
+	}
+}
+
+func TestWithLenient(t *testing.T) {
+	testCases := []struct {
+		Key      string
+		Expected bool
+		Error    error
+		TestName string
+	}{
+		{" BNGH-7C75-FN ", true, nil, "Separators and whitespace"},
+		{"bngh 7c75 fn", true, nil, "Lowercase with spaces"},
+		{"BNGH-7C75-FM", false, nil, "Wrong check character"},
+		{"BNGH-7C75", false, ErrInvalidLength, "Too short"},
+		{"BNGH.7C75FN", false, ErrInvalidLength, "Other punctuation is not removed"},
+		{"ſNGH-7C75-FN", false, ErrInvalidLength, "Non-ASCII letters are not uppercased"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			for _, opts := range [][]Option{{WithLenient()}, {WithLenient(), WithFastKernel()}} {
+				isValid, err := VerifyKey(tc.Key, opts...)
+				assert.Equal(t, tc.Expected, isValid)
+				if tc.Error != nil {
+					assert.ErrorIs(t, err, tc.Error)
+				} else {
+					assert.NoError(t, err)
+				}
+			}
+		})
+	}
+
+	_, err := VerifyKey("BNGH-7C75-FN ")
+	assert.ErrorIs(t, err, ErrInvalidLength, "Separators are rejected without WithLenient")
+}
+
+func TestStripSeparators(t *testing.T) {
+	assert.Equal(t, "BNGH7c75FN", StripSeparators(" BNGH-7c75 FN\t"))
+	assert.Equal(t, "BNGH.7C75/FN", StripSeparators("BNGH.7C75/FN"), "Other punctuation is kept")
+}
@@ -0,0 +1,158 @@
+package usivalidator
+
+import "strings"
+
+// OCRSubstitution is a single character replacement tried by CleanOCR.
+type OCRSubstitution struct {
+	// Position is the index of the character in the cleaned input.
+	Position int
+	// From is the character read by OCR.
+	From rune
+	// To is the valid look-alike substituted for it.
+	To rune
+}
+
+// OCRAttempt is one candidate USI considered by CleanOCR.
+type OCRAttempt struct {
+	// Candidate is the cleaned input with Substitutions applied.
+	Candidate string
+	// Substitutions lists the replacements made, in position order.
+	Substitutions []OCRSubstitution
+	// Valid is true if Candidate is a checksum-valid USI.
+	Valid bool
+}
+
+// OCRReport describes how CleanOCR recovered, or failed to recover, a USI.
+type OCRReport struct {
+	// Input is the text as read by OCR.
+	Input string
+	// Cleaned is Input in uppercase with punctuation and whitespace removed.
+	Cleaned string
+	// Stripped holds the characters removed from Input, in order.
+	Stripped string
+	// Attempts lists every candidate tried, in the order tried.
+	Attempts []OCRAttempt
+	// USI is the recovered USI, or empty if none or more than one candidate was valid.
+	USI string
+	// Ambiguous is true if several equally likely candidates were valid.
+	Ambiguous bool
+}
+
+// CleanOCR recovers a USI from OCR output, e.g. from digitised paper
+// enrolment records. It removes stray punctuation and whitespace, then
+// replaces characters that cannot appear in a USI (0, 1, I, O) with their
+// look-alikes. If no candidate is valid, it also tries one further look-alike
+// substitution (such as 5/S, 8/B or 2/Z) anywhere in the key. Every candidate
+// tried is recorded in the report.
+//
+// Parameters:
+// - input (string): The text read by OCR.
+//
+// Returns:
+// - (OCRReport): The cleanup report. USI is set if exactly one candidate was valid.
+//
+// Usage:
+// report := CleanOCR("BNGH-7C7S FN.")
+// fmt.Println(report.USI) // BNGH7C75FN
+
+func CleanOCR(input string) OCRReport {
+	report := OCRReport{Input: input}
+
+	var cleaned, stripped strings.Builder
+	for _, char := range input {
+		if char < 0x80 && (char >= '0' && char <= '9' || char >= 'A' && char <= 'Z' || char >= 'a' && char <= 'z') {
+			cleaned.WriteByte(byte(char))
+		} else {
+			stripped.WriteRune(char)
+		}
+	}
+	report.Cleaned = strings.ToUpper(cleaned.String())
+	report.Stripped = stripped.String()
+	if len(report.Cleaned) != 10 {
+		return report
+	}
+
+	// Characters outside the alphabet must be replaced in every candidate.
+	base := []OCRAttempt{{Candidate: report.Cleaned}}
+	for i := 0; i < len(report.Cleaned); i++ {
+		from := rune(report.Cleaned[i])
+		if indexOf(from, ValidCharacters) != -1 {
+			continue
+		}
+		var next []OCRAttempt
+		for _, attempt := range base {
+			for _, to := range confusables[from] {
+				next = append(next, attempt.substitute(i, to))
+			}
+		}
+		base = next
+	}
+
+	if report.try(base) {
+		return report
+	}
+
+	var extra []OCRAttempt
+	for _, attempt := range base {
+		for i := 0; i < len(attempt.Candidate); i++ {
+			if attempt.substituted(i) {
+				continue
+			}
+			for _, to := range confusables[rune(attempt.Candidate[i])] {
+				extra = append(extra, attempt.substitute(i, to))
+			}
+		}
+	}
+	report.try(extra)
+	return report
+}
+
+// try verifies attempts, records them and sets USI or Ambiguous. It reports
+// whether any attempt was valid.
+func (r *OCRReport) try(attempts []OCRAttempt) bool {
+	valid := 0
+	for _, attempt := range attempts {
+		attempt.Valid, _ = VerifyKey(attempt.Candidate)
+		r.Attempts = append(r.Attempts, attempt)
+		if attempt.Valid {
+			valid++
+			r.USI = attempt.Candidate
+		}
+	}
+	if valid > 1 {
+		r.USI = ""
+		r.Ambiguous = true
+	}
+	return valid > 0
+}
+
+// substitute returns a copy of a with the character at i replaced by to.
+func (a OCRAttempt) substitute(i int, to rune) OCRAttempt {
+	candidate := []byte(a.Candidate)
+	from := rune(candidate[i])
+	candidate[i] = byte(to)
+
+	substitutions := make([]OCRSubstitution, 0, len(a.Substitutions)+1)
+	for _, s := range a.Substitutions {
+		if s.Position < i {
+			substitutions = append(substitutions, s)
+		}
+	}
+	substitutions = append(substitutions, OCRSubstitution{Position: i, From: from, To: to})
+	for _, s := range a.Substitutions {
+		if s.Position > i {
+			substitutions = append(substitutions, s)
+		}
+	}
+	return OCRAttempt{Candidate: string(candidate), Substitutions: substitutions}
+}
+
+// substituted reports whether position i has already been replaced.
+func (a OCRAttempt) substituted(i int) bool {
+	for _, s := range a.Substitutions {
+		if s.Position == i {
+			return true
+		}
+	}
+	return false
+}
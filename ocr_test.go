@@ -0,0 +1,64 @@
+package usivalidator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCleanOCR(t *testing.T) {
+	testCases := []struct {
+		Input     string
+		Cleaned   string
+		Stripped  string
+		USI       string
+		Attempts  int
+		Ambiguous bool
+		TestName  string
+	}{
+		{"BNGH7C75FN", "BNGH7C75FN", "", "BNGH7C75FN", 1, false, "Already valid"},
+		{" bngh-7c75 fn. ", "BNGH7C75FN", " - . ", "BNGH7C75FN", 1, false, "Punctuation, whitespace and case"},
+		{"BNGH7C7SFN", "BNGH7C7SFN", "", "BNGH7C75FN", 0, false, "Look-alike in the alphabet"},
+		{"BNGH7C7", "BNGH7C7", "", "", 0, false, "Too short after cleanup"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			report := CleanOCR(tc.Input)
+			assert.Equal(t, tc.Input, report.Input)
+			assert.Equal(t, tc.Cleaned, report.Cleaned)
+			assert.Equal(t, tc.Stripped, report.Stripped)
+			assert.Equal(t, tc.USI, report.USI)
+			assert.Equal(t, tc.Ambiguous, report.Ambiguous)
+			if tc.Attempts > 0 {
+				assert.Len(t, report.Attempts, tc.Attempts)
+			}
+		})
+	}
+}
+
+func TestCleanOCRReportsSubstitutions(t *testing.T) {
+	report := CleanOCR("BNGH7C7SFN")
+	var valid []OCRAttempt
+	for _, attempt := range report.Attempts {
+		if attempt.Valid {
+			valid = append(valid, attempt)
+		}
+	}
+
+	assert.Equal(t, "BNGH7C7SFN", report.Attempts[0].Candidate, "Unmodified input should be tried first")
+	assert.Empty(t, report.Attempts[0].Substitutions)
+	if assert.Len(t, valid, 1) {
+		assert.Equal(t, []OCRSubstitution{{Position: 7, From: 'S', To: '5'}}, valid[0].Substitutions)
+	}
+}
+
+func TestCleanOCRReplacesInvalidCharacters(t *testing.T) {
+	// '0' can never appear in a USI, so every candidate replaces it.
+	report := CleanOCR("0NGH7C75FN")
+	assert.NotEmpty(t, report.Attempts)
+	for _, attempt := range report.Attempts {
+		assert.NotContains(t, attempt.Candidate, "0")
+		assert.Equal(t, OCRSubstitution{Position: 0, From: '0', To: rune(attempt.Candidate[0])}, attempt.Substitutions[0])
+	}
+}
@@ -0,0 +1,105 @@
+package usivalidator
+
+import "strings"
+
+// Set is a collection of USIs that can be queried for membership. Blockset
+// implements Set. Keys are passed in uppercase.
+type Set interface {
+	Contains(usi string) bool
+}
+
+// Option configures VerifyKey and verifiers created by NewVerifier.
+type Option func(*options)
+
+type options struct {
+	blocklist   Set
+	allowlist   Set
+	suggestions bool
+	fastKernel  bool
+	lenient     bool
+	strictCase  bool
+}
+
+func newOptions(opts []Option) options {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// checkLists applies the blocklist and allowlist to a key with a valid check character.
+func (o options) checkLists(key string) (bool, error) {
+	if o.blocklist == nil && o.allowlist == nil {
+		return true, nil
+	}
+
+	key = strings.ToUpper(key)
+	if o.blocklist != nil && o.blocklist.Contains(key) {
+		return false, ErrBlocked
+	}
+	if o.allowlist != nil && !o.allowlist.Contains(key) {
+		return false, ErrNotAllowed
+	}
+	return true, nil
+}
+
+// WithBlocklist rejects USIs found in set with ErrBlocked, even when their
+// check character is valid.
+//
+// Parameters:
+// - set (Set): The blocked USIs.
+//
+// Returns:
+// - (Option): The option to pass to VerifyKey.
+//
+// Usage:
+// isValid, err := VerifyKey("BNGH7C75FN", WithBlocklist(revoked))
+// if errors.Is(err, ErrBlocked) {
+//     fmt.Println("The USI is blocked!")
+// }
+
+func WithBlocklist(set Set) Option {
+	return func(o *options) {
+		o.blocklist = set
+	}
+}
+
+// WithAllowlist rejects USIs missing from set with ErrNotAllowed, even when
+// their check character is valid.
+//
+// Parameters:
+// - set (Set): The permitted USIs, e.g. an exam cohort.
+//
+// Returns:
+// - (Option): The option to pass to VerifyKey.
+//
+// Usage:
+// isValid, err := VerifyKey("BNGH7C75FN", WithAllowlist(cohort))
+// if errors.Is(err, ErrNotAllowed) {
+//     fmt.Println("The USI is not in this cohort!")
+// }
+
+func WithAllowlist(set Set) Option {
+	return func(o *options) {
+		o.allowlist = set
+	}
+}
+
+// NewVerifier returns a Verifier that calls VerifyKey with opts.
+//
+// Parameters:
+// - opts (...Option): The options applied to every verification.
+//
+// Returns:
+// - (Verifier): The configured verifier.
+//
+// Usage:
+// verifier := NewVerifier(WithBlocklist(revoked))
+// isValid, err := verifier.VerifyKey("BNGH7C75FN")
+
+func NewVerifier(opts ...Option) Verifier {
+	return VerifierFunc(func(key string) (bool, error) {
+		return VerifyKey(key, opts...)
+	})
+}
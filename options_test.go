@@ -0,0 +1,56 @@
+package usivalidator
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func ExampleWithBlocklist() {
+	revoked := NewBlockset(100, 0)
+	revoked.Add("BNGH7C75FN")
+
+	isValid, err := VerifyKey("BNGH7C75FN", WithBlocklist(revoked))
+	fmt.Println(isValid, err)
+
+	// Output: false usi is blocklisted
+}
+
+func TestVerifyKeyOptions(t *testing.T) {
+	blocked := NewBlockset(10, 0)
+	blocked.Add("BNGH7C75FN")
+
+	cohort := NewBlockset(10, 0)
+	cohort.Add("BNGH7C75FN")
+	cohort.Add("BP6LKB3C7X")
+
+	testCases := []struct {
+		USI         string
+		Options     []Option
+		IsValid     bool
+		ExpectedErr error
+		TestName    string
+	}{
+		{"BNGH7C75FN", nil, true, nil, "No options"},
+		{"BNGH7C75FN", []Option{WithBlocklist(blocked)}, false, ErrBlocked, "Blocked USI"},
+		{"bngh7c75fn", []Option{WithBlocklist(blocked)}, false, ErrBlocked, "Blocked lowercase USI"},
+		{"BP6LKB3C7X", []Option{WithBlocklist(blocked)}, true, nil, "Not blocked"},
+		{"BNGH7C75FX", []Option{WithBlocklist(blocked)}, false, nil, "Invalid check character is reported before blocklist"},
+		{"RVJ5DM8LXJ", []Option{WithAllowlist(cohort)}, false, ErrNotAllowed, "Not in allowlist"},
+		{"BP6LKB3C7X", []Option{WithAllowlist(cohort)}, true, nil, "In allowlist"},
+		{"BNGH7C75FN", []Option{WithAllowlist(cohort), WithBlocklist(blocked)}, false, ErrBlocked, "Blocklist takes precedence over allowlist"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			isValid, err := VerifyKey(tc.USI, tc.Options...)
+			assert.Equal(t, tc.IsValid, isValid)
+			assert.Equal(t, tc.ExpectedErr, err)
+
+			isValid, err = NewVerifier(tc.Options...).VerifyKey(tc.USI)
+			assert.Equal(t, tc.IsValid, isValid)
+			assert.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package usivalidator
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// parallelChunk is the number of keys a worker claims at a time. Claiming
+// keys in chunks keeps coordination overhead low for very large batches.
+const parallelChunk = 1024
+
+// VerifyKeysParallel validates a batch of USIs like VerifyKeys, spreading the
+// work across a pool of goroutines. It is intended for very large batches,
+// such as historical records during a migration.
+//
+// Parameters:
+// - ctx (context.Context): Cancelling ctx stops the workers.
+// - keys ([]string): The USIs to validate.
+// - workers (int): The number of goroutines. Values below 1 use runtime.GOMAXPROCS(0).
+// - opts (...Option): Options applied to every key.
+//
+// Returns:
+// - ([]Result): One result per key, in input order.
+// - (error): ctx.Err() if ctx was cancelled before every key was validated. A
+//   cancellation after the last key is validated is ignored.
+//
+// Usage:
+// results, err := VerifyKeysParallel(ctx, keys, 8, WithFastKernel())
+// if err != nil {
+//     log.Fatal(err)
+// }
+
+func VerifyKeysParallel(ctx context.Context, keys []string, workers int, opts ...Option) ([]Result, error) {
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if chunks := (len(keys) + parallelChunk - 1) / parallelChunk; workers > chunks {
+		workers = chunks
+	}
+
+	o := newOptions(opts)
+	results := make([]Result, len(keys))
+	var next atomic.Int64
+	var stopped atomic.Bool
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for {
+				start := int(next.Add(parallelChunk)) - parallelChunk
+				if start >= len(keys) {
+					return
+				}
+				if ctx.Err() != nil {
+					// The chunk just claimed is left unvalidated.
+					stopped.Store(true)
+					return
+				}
+				end := min(start+parallelChunk, len(keys))
+				for i := start; i < end; i++ {
+					results[i] = verifyResult(keys[i], o)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if stopped.Load() {
+		return nil, ctx.Err()
+	}
+	return results, nil
+}
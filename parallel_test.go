@@ -0,0 +1,68 @@
+package usivalidator
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifyKeysParallel(t *testing.T) {
+	keys := referenceCorpus(5000)
+	expected := VerifyKeys(keys)
+
+	for _, workers := range []int{0, 1, 3, 64} {
+		results, err := VerifyKeysParallel(context.Background(), keys, workers)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, results, "workers=%d", workers)
+	}
+}
+
+func TestVerifyKeysParallelEmpty(t *testing.T) {
+	results, err := VerifyKeysParallel(context.Background(), nil, 4)
+	assert.NoError(t, err)
+	assert.Empty(t, results)
+}
+
+func TestVerifyKeysParallelCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results, err := VerifyKeysParallel(ctx, referenceCorpus(100), 2)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Nil(t, results)
+}
+
+// lateCancelContext reports cancellation once Err has been called more than
+// after times, standing in for a context cancelled at a precise point.
+type lateCancelContext struct {
+	context.Context
+	after int32
+	calls atomic.Int32
+}
+
+func (c *lateCancelContext) Err() error {
+	if c.calls.Add(1) > c.after {
+		return context.Canceled
+	}
+	return nil
+}
+
+func TestVerifyKeysParallelCancelledAfterLastChunk(t *testing.T) {
+	keys := referenceCorpus(100)
+	ctx := &lateCancelContext{Context: context.Background(), after: 1}
+
+	results, err := VerifyKeysParallel(ctx, keys, 1)
+	assert.NoError(t, err, "Cancelling after every key was validated should not discard the results")
+	assert.Equal(t, VerifyKeys(keys), results)
+	assert.Error(t, ctx.Err())
+}
+
+func BenchmarkVerifyKeysParallel(b *testing.B) {
+	keys := referenceCorpus(100000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		VerifyKeysParallel(context.Background(), keys, 0, WithFastKernel())
+	}
+}
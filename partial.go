@@ -0,0 +1,77 @@
+package usivalidator
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// PartialResult describes a USI that is still being typed.
+type PartialResult struct {
+	// Prefix is the input so far, in uppercase.
+	Prefix string
+	// Potential is true while the input can still be completed to a valid USI.
+	Potential bool
+	// Complete is true once all 10 characters have been entered.
+	Complete bool
+	// Valid is true if the input is a complete, valid USI.
+	Valid bool
+	// CheckChar is the expected check character once at least 9 characters
+	// have been entered, otherwise 0.
+	CheckChar rune
+	// Position is the rune index of the first problem, or -1 if there is none.
+	Position int
+	// Message explains the first problem, or is empty if there is none.
+	Message string
+}
+
+// ValidatePartial checks input as it is typed. Up to 9 characters, it reports
+// whether the prefix could still become a valid USI; at 9 it also gives the
+// check character that must follow; at 10 it verifies the complete USI.
+// It is cheap enough to call on every keystroke, including from the wasm build.
+//
+// Parameters:
+// - prefix (string): The characters entered so far. Case is ignored.
+//
+// Returns:
+// - (PartialResult): The state of the input.
+//
+// Usage:
+// result := ValidatePartial("BNGH7C75F")
+// fmt.Printf("Next character must be %c\n", result.CheckChar) // N
+
+func ValidatePartial(prefix string) PartialResult {
+	result := PartialResult{Prefix: strings.ToUpper(prefix), Position: -1}
+
+	chars := []rune(prefix)
+	for i, char := range chars {
+		if indexOf(unicode.ToUpper(char), ValidCharacters) == -1 {
+			result.Position = i
+			result.Message = describeInvalidCharacter(char)
+			return result
+		}
+	}
+
+	if len(chars) > 10 {
+		result.Position = 10
+		result.Message = fmt.Sprintf("expected 10 characters, got %d", len(chars))
+		return result
+	}
+
+	result.Potential = true
+	if len(chars) < 9 {
+		return result
+	}
+
+	result.CheckChar, _ = GenerateCheckCharacter(result.Prefix[:9])
+	if len(chars) == 10 {
+		result.Complete = true
+		result.Valid = rune(result.Prefix[9]) == result.CheckChar
+		if !result.Valid {
+			result.Potential = false
+			result.Position = 9
+			result.Message = fmt.Sprintf("check character should be '%c', not '%c'", result.CheckChar, chars[9])
+		}
+	}
+	return result
+}
@@ -0,0 +1,42 @@
+package usivalidator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatePartial(t *testing.T) {
+	testCases := []struct {
+		Prefix    string
+		Potential bool
+		Complete  bool
+		Valid     bool
+		CheckChar rune
+		Position  int
+		Message   string
+		TestName  string
+	}{
+		{"", true, false, false, 0, -1, "", "Nothing typed"},
+		{"b", true, false, false, 0, -1, "", "One character"},
+		{"BNGH7C75", true, false, false, 0, -1, "", "Eight characters"},
+		{"BNGH7C75F", true, false, false, 'N', -1, "", "Nine characters"},
+		{"bngh7c75f", true, false, false, 'N', -1, "", "Nine lowercase characters"},
+		{"BNGH7C75FN", true, true, true, 'N', -1, "", "Complete and valid"},
+		{"BNGH7C75FM", false, true, false, 'N', 9, "check character should be 'N', not 'M'", "Complete with wrong check character"},
+		{"BNGH7O", false, false, false, 0, 5, "'O' is not a valid USI character (I, O, 0 and 1 are never used)", "Invalid character"},
+		{"BNGH7C75FNX", false, false, false, 0, 10, "expected 10 characters, got 11", "Too long"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			result := ValidatePartial(tc.Prefix)
+			assert.Equal(t, tc.Potential, result.Potential)
+			assert.Equal(t, tc.Complete, result.Complete)
+			assert.Equal(t, tc.Valid, result.Valid)
+			assert.Equal(t, tc.CheckChar, result.CheckChar)
+			assert.Equal(t, tc.Position, result.Position)
+			assert.Equal(t, tc.Message, result.Message)
+		})
+	}
+}
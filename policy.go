@@ -0,0 +1,99 @@
+package usivalidator
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Policy declares an organisation's validation requirements in one place so
+// that every tool built on this package applies the same rules. A Policy can be
+// decoded from JSON; the Blocklist and Allowlist sets must be attached in code.
+type Policy struct {
+	// Name identifies the policy in logs and reports.
+	Name string `json:"name"`
+	// AcceptExemptions accepts ExemptionCodes in place of a USI.
+	AcceptExemptions bool `json:"accept_exemptions"`
+	// BannedPrefixes rejects USIs starting with any of these prefixes, such as
+	// ranges reserved for test data.
+	BannedPrefixes []string `json:"banned_prefixes"`
+	// Rules names additional registered rules applied after the built-in checks.
+	Rules []string `json:"rules"`
+	// Blocklist rejects the USIs it contains.
+	Blocklist Set `json:"-"`
+	// Allowlist rejects USIs it does not contain.
+	Allowlist Set `json:"-"`
+}
+
+// DefaultPolicy checks format and check character and accepts exemption codes.
+var DefaultPolicy = Policy{Name: "default", AcceptExemptions: true}
+
+// Chain builds the RuleChain described by the policy. Rules are applied in the
+// order: exemption, format, checksum, banned prefixes, blocklist, allowlist,
+// then any named rules.
+//
+// Returns:
+// - (*RuleChain): The rule chain for the policy.
+// - (error): An error if a named rule is not registered.
+//
+// Usage:
+// policy := Policy{Name: "production", BannedPrefixes: []string{"TEST"}}
+// chain, err := policy.Chain()
+// if err != nil {
+//     log.Fatal(err)
+// }
+// result := chain.Validate("BNGH7C75FN")
+
+func (p Policy) Chain() (*RuleChain, error) {
+	var rules []Rule
+	if p.AcceptExemptions {
+		rules = append(rules, ExemptionRule())
+	}
+	rules = append(rules, FormatRule(), ChecksumRule())
+	if len(p.BannedPrefixes) > 0 {
+		rules = append(rules, BannedPrefixRule(p.BannedPrefixes...))
+	}
+	if p.Blocklist != nil {
+		rules = append(rules, BlocklistRule(p.Blocklist))
+	}
+	if p.Allowlist != nil {
+		rules = append(rules, AllowlistRule(p.Allowlist))
+	}
+
+	for _, name := range p.Rules {
+		rule, ok := LookupRule(name)
+		if !ok {
+			return nil, fmt.Errorf("policy %q: rule %q is not registered", p.Name, name)
+		}
+		rules = append(rules, rule)
+	}
+
+	return Chain(rules...), nil
+}
+
+// BannedPrefixRule fails keys starting with any of prefixes with ErrBannedPrefix.
+// Matching ignores case. Empty prefixes are ignored rather than banning every key.
+//
+// Parameters:
+// - prefixes (...string): The banned prefixes.
+//
+// Returns:
+// - (Rule): A rule named "banned-prefix".
+
+func BannedPrefixRule(prefixes ...string) Rule {
+	banned := make([]string, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		if prefix != "" {
+			banned = append(banned, strings.ToUpper(prefix))
+		}
+	}
+
+	return ruleFunc{name: "banned-prefix", check: func(key string) (Outcome, error) {
+		key = strings.ToUpper(key)
+		for _, prefix := range banned {
+			if strings.HasPrefix(key, prefix) {
+				return Fail, ErrBannedPrefix
+			}
+		}
+		return Pass, nil
+	}}
+}
@@ -0,0 +1,68 @@
+package usivalidator
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPolicyChain(t *testing.T) {
+	revoked := NewBlockset(10, 0)
+	revoked.Add("BP6LKB3C7X")
+
+	testCases := []struct {
+		Policy      Policy
+		Key         string
+		IsValid     bool
+		ExpectedErr string
+		TestName    string
+	}{
+		{DefaultPolicy, "BNGH7C75FN", true, "", "Default policy accepts valid USI"},
+		{DefaultPolicy, "INTOFF", true, "", "Default policy accepts exemptions"},
+		{Policy{}, "INTOFF", false, "key length must be 10 characters", "Exemptions not accepted"},
+		{Policy{BannedPrefixes: []string{"bngh"}}, "BNGH7C75FN", false, "usi has a banned prefix", "Banned prefix"},
+		{Policy{BannedPrefixes: []string{"BNGH"}}, "RVJ5DM8LXJ", true, "", "Prefix not banned"},
+		{Policy{BannedPrefixes: []string{""}}, "BNGH7C75FN", true, "", "Empty prefix bans nothing"},
+		{Policy{Blocklist: revoked}, "BP6LKB3C7X", false, "usi is blocklisted", "Blocklist"},
+		{Policy{Allowlist: revoked}, "BNGH7C75FN", false, "usi is not in allowlist", "Allowlist"},
+		{Policy{Rules: []string{"format"}}, "BNGH7C75FX", false, "check character mismatch", "Checksum always applies"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			chain, err := tc.Policy.Chain()
+			assert.NoError(t, err)
+
+			result := chain.Validate(tc.Key)
+			assert.Equal(t, tc.IsValid, result.Valid)
+			if tc.ExpectedErr != "" {
+				assert.EqualError(t, result.Err, tc.ExpectedErr)
+			} else {
+				assert.NoError(t, result.Err)
+			}
+		})
+	}
+}
+
+func TestPolicyChainUnknownRule(t *testing.T) {
+	_, err := Policy{Name: "production", Rules: []string{"missing"}}.Chain()
+	assert.EqualError(t, err, `policy "production": rule "missing" is not registered`)
+}
+
+func TestPolicyJSON(t *testing.T) {
+	var policy Policy
+	err := json.Unmarshal([]byte(`{
+		"name": "production",
+		"accept_exemptions": true,
+		"banned_prefixes": ["TEST"],
+		"rules": ["checksum"]
+	}`), &policy)
+	assert.NoError(t, err)
+	assert.Equal(t, Policy{
+		Name:             "production",
+		AcceptExemptions: true,
+		BannedPrefixes:   []string{"TEST"},
+		Rules:            []string{"checksum"},
+	}, policy)
+}
@@ -0,0 +1,135 @@
+package usivalidator
+
+import (
+	"bufio"
+	"math/rand/v2"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// referenceAlphabet is the USI character set, written out independently of ValidCharacters.
+const referenceAlphabet = "23456789ABCDEFGHJKLMNPQRSTUVWXYZ"
+
+// referenceVerifyKey is a deliberately naive implementation of USI validation,
+// written directly from the Luhn Mod N description, used to cross-check the
+// package implementation and as a throughput baseline.
+func referenceVerifyKey(key string) bool {
+	key = strings.ToUpper(key)
+	if len(key) != 10 {
+		return false
+	}
+
+	n := len(referenceAlphabet)
+	sum := 0
+	for i := 8; i >= 0; i-- {
+		codePoint := strings.IndexByte(referenceAlphabet, key[i])
+		if codePoint < 0 {
+			return false
+		}
+		factor := 1
+		if (8-i)%2 == 0 {
+			factor = 2
+		}
+		addend := factor * codePoint
+		sum += addend/n + addend%n
+	}
+
+	return key[9] == referenceAlphabet[(n-sum%n)%n]
+}
+
+// referenceCorpus returns a reproducible mix of valid, invalid and malformed keys.
+func referenceCorpus(size int) []string {
+	r := rand.New(rand.NewPCG(489, 10))
+	keys := make([]string, size)
+	buf := make([]byte, 10)
+	for i := range keys {
+		for j := range buf {
+			buf[j] = referenceAlphabet[r.IntN(len(referenceAlphabet))]
+		}
+		switch i % 4 {
+		case 0:
+			// Random key; almost always has a wrong check character.
+		case 1, 2:
+			checkChar, _ := GenerateCheckCharacter(string(buf[:9]))
+			buf[9] = byte(checkChar)
+		case 3:
+			buf[r.IntN(10)] = "01IO-!"[r.IntN(6)]
+		}
+		keys[i] = string(buf)
+	}
+	vectors, _ := ConformanceVectors()
+	for _, v := range vectors {
+		keys = append(keys, v.Key)
+	}
+	return keys
+}
+
+func TestReferenceAgreement(t *testing.T) {
+	keys := referenceCorpus(100000)
+
+	agree := 0
+	for _, key := range keys {
+		isValid, _ := VerifyKey(key)
+		if isValid == referenceVerifyKey(key) {
+			agree++
+		} else {
+			t.Errorf("%s: package says %v, reference says %v", key, isValid, !isValid)
+		}
+	}
+	t.Logf("agreement with naive reference: %d/%d", agree, len(keys))
+}
+
+// TestExternalReferenceAgreement compares against an external reference
+// implementation, such as a wrapper around the published .NET or Java code.
+// Set USIVALIDATOR_REFERENCE_CMD to a command that reads one key per line on
+// standard input and writes "true" or "false" per line on standard output.
+func TestExternalReferenceAgreement(t *testing.T) {
+	command := os.Getenv("USIVALIDATOR_REFERENCE_CMD")
+	if command == "" {
+		t.Skip("USIVALIDATOR_REFERENCE_CMD not set")
+	}
+
+	keys := referenceCorpus(10000)
+	cmd := exec.Command("sh", "-c", command)
+	cmd.Stdin = strings.NewReader(strings.Join(keys, "\n") + "\n")
+	output, err := cmd.Output()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	scanner := bufio.NewScanner(strings.NewReader(string(output)))
+	agree, i := 0, 0
+	for ; scanner.Scan() && i < len(keys); i++ {
+		expected := strings.TrimSpace(scanner.Text()) == "true"
+		isValid, _ := VerifyKey(keys[i])
+		if isValid == expected {
+			agree++
+		} else {
+			t.Errorf("%s: package says %v, external reference says %v", keys[i], isValid, expected)
+		}
+	}
+	assert.Equal(t, len(keys), i, "External reference returned too few results")
+	t.Logf("agreement with external reference: %d/%d", agree, len(keys))
+}
+
+func BenchmarkVerifyKey(b *testing.B) {
+	keys := referenceCorpus(1024)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		VerifyKey(keys[i%len(keys)])
+	}
+	b.ReportMetric(float64(b.N)/b.Elapsed().Seconds(), "keys/s")
+}
+
+func BenchmarkReferenceVerifyKey(b *testing.B) {
+	keys := referenceCorpus(1024)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		referenceVerifyKey(keys[i%len(keys)])
+	}
+	b.ReportMetric(float64(b.N)/b.Elapsed().Seconds(), "keys/s")
+}
@@ -0,0 +1,333 @@
+package usivalidator
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// ExemptionCodes are the AVETMISS values accepted in place of a USI for students
+// who are exempt from holding one.
+var ExemptionCodes = []string{"INDIV", "INTOFF"}
+
+// Outcome is the result of applying a single Rule.
+type Outcome int
+
+const (
+	// Pass means the rule is satisfied and the chain continues.
+	Pass Outcome = iota
+	// Fail means the rule rejected the key and the chain stops.
+	Fail
+	// Accept means the rule accepted the key outright and the chain stops.
+	Accept
+)
+
+// String returns the lowercase name of the outcome.
+func (o Outcome) String() string {
+	switch o {
+	case Pass:
+		return "pass"
+	case Fail:
+		return "fail"
+	case Accept:
+		return "accept"
+	}
+	return "unknown"
+}
+
+// Rule is a single validation policy step. Name identifies the rule in results
+// and reports; Check inspects the key and returns an outcome along with the
+// reason for a failure.
+type Rule interface {
+	Name() string
+	Check(key string) (Outcome, error)
+}
+
+// RuleOutcome records how one rule in a chain handled a key.
+type RuleOutcome struct {
+	Rule    string
+	Outcome Outcome
+	Err     error
+}
+
+// Result is the combined outcome of validating a key.
+type Result struct {
+	Key   string
+	Valid bool
+	// Err is the reason the key is invalid, if one was given.
+	Err error
+	// Rules lists the outcome of each rule that was applied, in order.
+	Rules []RuleOutcome
+}
+
+// RuleChain applies rules in order until one fails or accepts the key.
+// A RuleChain is a Verifier.
+type RuleChain struct {
+	rules []Rule
+}
+
+// Chain combines rules into a RuleChain. Rules are applied in the order given,
+// so rules that can accept a key outright (such as ExemptionRule) should come first.
+//
+// Parameters:
+// - rules (...Rule): The rules to apply.
+//
+// Returns:
+// - (*RuleChain): The combined rule chain.
+//
+// Usage:
+// chain := Chain(ExemptionRule(), FormatRule(), ChecksumRule(), BlocklistRule(revoked))
+// result := chain.Validate("BNGH7C75FN")
+// for _, r := range result.Rules {
+//     fmt.Println(r.Rule, r.Outcome)
+// }
+
+func Chain(rules ...Rule) *RuleChain {
+	return &RuleChain{rules: rules}
+}
+
+// Validate applies the chain to key. The key is valid if every applied rule
+// passed or one accepted it; an empty chain accepts every key.
+//
+// Parameters:
+// - key (string): The value to validate.
+//
+// Returns:
+// - (Result): The combined result, with one RuleOutcome per applied rule.
+
+func (c *RuleChain) Validate(key string) Result {
+	result := Result{Key: key, Valid: true}
+	for _, rule := range c.rules {
+		outcome, err := rule.Check(key)
+		result.Rules = append(result.Rules, RuleOutcome{Rule: rule.Name(), Outcome: outcome, Err: err})
+
+		switch outcome {
+		case Fail:
+			result.Valid = false
+			result.Err = err
+			return result
+		case Accept:
+			return result
+		}
+	}
+	return result
+}
+
+// VerifyKey applies the chain to key, reporting the failing rule's error if any.
+func (c *RuleChain) VerifyKey(key string) (bool, error) {
+	result := c.Validate(key)
+	if result.Valid || errors.Is(result.Err, ErrCheckCharMismatch) {
+		return result.Valid, nil
+	}
+	return false, result.Err
+}
+
+// NewRule creates a Rule from a name and a check function, for use in chains
+// or with RegisterRule.
+//
+// Parameters:
+// - name (string): The name reported in results.
+// - check (func(string) (Outcome, error)): The check to run against each key.
+//
+// Returns:
+// - (Rule): The rule.
+//
+// Usage:
+// noTestPrefix := NewRule("no-test-prefix", func(key string) (Outcome, error) {
+//     if strings.HasPrefix(strings.ToUpper(key), "TEST") {
+//         return Fail, errors.New("test USIs are not allowed in production")
+//     }
+//     return Pass, nil
+// })
+
+func NewRule(name string, check func(key string) (Outcome, error)) Rule {
+	return ruleFunc{name: name, check: check}
+}
+
+var (
+	rulesMu         sync.RWMutex
+	registeredRules = map[string]Rule{
+		"format":    FormatRule(),
+		"checksum":  ChecksumRule(),
+		"exemption": ExemptionRule(),
+	}
+)
+
+// RegisterRule makes rule available to LookupRule and ChainByName under its
+// name. The built-in "format", "checksum" and "exemption" rules are registered
+// by default.
+//
+// Parameters:
+// - rule (Rule): The rule to register.
+//
+// Returns:
+// - (error): An error if the rule has no name or the name is already registered.
+//
+// Usage:
+// if err := RegisterRule(noTestPrefix); err != nil {
+//     log.Fatal(err)
+// }
+
+func RegisterRule(rule Rule) error {
+	name := rule.Name()
+	if name == "" {
+		return errors.New("rule name must not be empty")
+	}
+
+	rulesMu.Lock()
+	defer rulesMu.Unlock()
+
+	if _, exists := registeredRules[name]; exists {
+		return fmt.Errorf("rule %q is already registered", name)
+	}
+	registeredRules[name] = rule
+	return nil
+}
+
+// LookupRule returns the registered rule with the given name.
+func LookupRule(name string) (Rule, bool) {
+	rulesMu.RLock()
+	defer rulesMu.RUnlock()
+
+	rule, ok := registeredRules[name]
+	return rule, ok
+}
+
+// RegisteredRules returns the names of all registered rules in sorted order.
+func RegisteredRules() []string {
+	rulesMu.RLock()
+	defer rulesMu.RUnlock()
+
+	names := make([]string, 0, len(registeredRules))
+	for name := range registeredRules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ChainByName builds a RuleChain from registered rule names, in the order given.
+//
+// Parameters:
+// - names (...string): The names of registered rules.
+//
+// Returns:
+// - (*RuleChain): The combined rule chain.
+// - (error): An error if any name is not registered.
+//
+// Usage:
+// chain, err := ChainByName("exemption", "format", "checksum", "no-test-prefix")
+
+func ChainByName(names ...string) (*RuleChain, error) {
+	chain := make([]Rule, 0, len(names))
+	for _, name := range names {
+		rule, ok := LookupRule(name)
+		if !ok {
+			return nil, fmt.Errorf("rule %q is not registered", name)
+		}
+		chain = append(chain, rule)
+	}
+	return Chain(chain...), nil
+}
+
+type ruleFunc struct {
+	name  string
+	check func(key string) (Outcome, error)
+}
+
+func (r ruleFunc) Name() string                      { return r.name }
+func (r ruleFunc) Check(key string) (Outcome, error) { return r.check(key) }
+
+// FormatRule fails keys that are not 10 characters from ValidCharacters. Case is ignored.
+//
+// Returns:
+// - (Rule): A rule named "format".
+
+func FormatRule() Rule {
+	return ruleFunc{name: "format", check: func(key string) (Outcome, error) {
+		if _, err := Encode(key); err != nil {
+			return Fail, err
+		}
+		return Pass, nil
+	}}
+}
+
+// ChecksumRule fails keys whose check character does not match.
+//
+// Returns:
+// - (Rule): A rule named "checksum".
+
+func ChecksumRule() Rule {
+	return ruleFunc{name: "checksum", check: func(key string) (Outcome, error) {
+		isValid, err := VerifyKey(key)
+		if err != nil {
+			return Fail, err
+		}
+		if !isValid {
+			return Fail, mismatchError(key)
+		}
+		return Pass, nil
+	}}
+}
+
+// ExemptionRule accepts exemption codes in place of a USI. Matching ignores
+// case and surrounding whitespace.
+//
+// Parameters:
+// - codes (...string): The accepted codes. Defaults to ExemptionCodes when none are given.
+//
+// Returns:
+// - (Rule): A rule named "exemption".
+
+func ExemptionRule(codes ...string) Rule {
+	if len(codes) == 0 {
+		codes = ExemptionCodes
+	}
+	accepted := make(map[string]bool, len(codes))
+	for _, code := range codes {
+		accepted[strings.ToUpper(code)] = true
+	}
+
+	return ruleFunc{name: "exemption", check: func(key string) (Outcome, error) {
+		if accepted[strings.ToUpper(strings.TrimSpace(key))] {
+			return Accept, nil
+		}
+		return Pass, nil
+	}}
+}
+
+// BlocklistRule fails keys found in set with ErrBlocked.
+//
+// Parameters:
+// - set (Set): The blocked USIs.
+//
+// Returns:
+// - (Rule): A rule named "blocklist".
+
+func BlocklistRule(set Set) Rule {
+	return ruleFunc{name: "blocklist", check: func(key string) (Outcome, error) {
+		if set.Contains(strings.ToUpper(key)) {
+			return Fail, ErrBlocked
+		}
+		return Pass, nil
+	}}
+}
+
+// AllowlistRule fails keys missing from set with ErrNotAllowed.
+//
+// Parameters:
+// - set (Set): The permitted USIs.
+//
+// Returns:
+// - (Rule): A rule named "allowlist".
+
+func AllowlistRule(set Set) Rule {
+	return ruleFunc{name: "allowlist", check: func(key string) (Outcome, error) {
+		if !set.Contains(strings.ToUpper(key)) {
+			return Fail, ErrNotAllowed
+		}
+		return Pass, nil
+	}}
+}
@@ -0,0 +1,149 @@
+package usivalidator
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func ExampleChain() {
+	revoked := NewBlockset(100, 0)
+	revoked.Add("BP6LKB3C7X")
+
+	chain := Chain(ExemptionRule(), FormatRule(), ChecksumRule(), BlocklistRule(revoked))
+	for _, key := range []string{"BNGH7C75FN", "BP6LKB3C7X", "INTOFF"} {
+		result := chain.Validate(key)
+		last := result.Rules[len(result.Rules)-1]
+		fmt.Println(key, result.Valid, last.Rule, last.Outcome)
+	}
+
+	// Output:
+	// BNGH7C75FN true blocklist pass
+	// BP6LKB3C7X false blocklist fail
+	// INTOFF true exemption accept
+}
+
+func TestChain(t *testing.T) {
+	revoked := NewBlockset(10, 0)
+	revoked.Add("BP6LKB3C7X")
+	cohort := NewBlockset(10, 0)
+	cohort.Add("BNGH7C75FN")
+	cohort.Add("BP6LKB3C7X")
+
+	chain := Chain(ExemptionRule(), FormatRule(), ChecksumRule(), BlocklistRule(revoked), AllowlistRule(cohort))
+
+	testCases := []struct {
+		Key         string
+		IsValid     bool
+		ExpectedErr string
+		Outcomes    []Outcome
+		TestName    string
+	}{
+		{"BNGH7C75FN", true, "", []Outcome{Pass, Pass, Pass, Pass, Pass}, "Valid USI"},
+		{"bngh7c75fn", true, "", []Outcome{Pass, Pass, Pass, Pass, Pass}, "Lowercase USI"},
+		{"intoff", true, "", []Outcome{Accept}, "Exemption code"},
+		{" INDIV ", true, "", []Outcome{Accept}, "Exemption code with whitespace"},
+		{"BNGH7C75F", false, "key length must be 10 characters", []Outcome{Pass, Fail}, "Invalid length"},
+		{"BNGH7C75FX", false, "check character mismatch", []Outcome{Pass, Pass, Fail}, "Wrong check character"},
+		{"BP6LKB3C7X", false, "usi is blocklisted", []Outcome{Pass, Pass, Pass, Fail}, "Blocked"},
+		{"RVJ5DM8LXJ", false, "usi is not in allowlist", []Outcome{Pass, Pass, Pass, Pass, Fail}, "Not allowed"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			result := chain.Validate(tc.Key)
+			assert.Equal(t, tc.Key, result.Key)
+			assert.Equal(t, tc.IsValid, result.Valid)
+			if tc.ExpectedErr != "" {
+				assert.EqualError(t, result.Err, tc.ExpectedErr)
+			} else {
+				assert.NoError(t, result.Err)
+			}
+
+			outcomes := make([]Outcome, len(result.Rules))
+			for i, r := range result.Rules {
+				outcomes[i] = r.Outcome
+			}
+			assert.Equal(t, tc.Outcomes, outcomes)
+		})
+	}
+}
+
+func TestChainVerifyKey(t *testing.T) {
+	chain := Chain(FormatRule(), ChecksumRule())
+
+	isValid, err := chain.VerifyKey("BNGH7C75FN")
+	assert.True(t, isValid)
+	assert.NoError(t, err)
+
+	isValid, err = chain.VerifyKey("BNGH7C75FX")
+	assert.False(t, isValid)
+	assert.NoError(t, err, "A wrong check character is not an error, matching VerifyKey")
+
+	isValid, err = chain.VerifyKey("BAD")
+	assert.False(t, isValid)
+	assert.EqualError(t, err, "key length must be 10 characters")
+}
+
+func TestEmptyChain(t *testing.T) {
+	result := Chain().Validate("anything")
+	assert.True(t, result.Valid)
+	assert.Empty(t, result.Rules)
+}
+
+func TestRuleNames(t *testing.T) {
+	set := NewBlockset(1, 0)
+	rules := []Rule{FormatRule(), ChecksumRule(), ExemptionRule("X"), BlocklistRule(set), AllowlistRule(set)}
+	names := make([]string, len(rules))
+	for i, r := range rules {
+		names[i] = r.Name()
+	}
+	assert.Equal(t, []string{"format", "checksum", "exemption", "blocklist", "allowlist"}, names)
+}
+
+func TestOutcomeString(t *testing.T) {
+	assert.Equal(t, "pass", Pass.String())
+	assert.Equal(t, "fail", Fail.String())
+	assert.Equal(t, "accept", Accept.String())
+	assert.Equal(t, "unknown", Outcome(99).String())
+}
+
+func TestRegisterRule(t *testing.T) {
+	noTestPrefix := NewRule("test-no-test-prefix", func(key string) (Outcome, error) {
+		if strings.HasPrefix(strings.ToUpper(key), "TEST") {
+			return Fail, errors.New("test USIs are not allowed")
+		}
+		return Pass, nil
+	})
+
+	assert.NoError(t, RegisterRule(noTestPrefix))
+	t.Cleanup(func() {
+		rulesMu.Lock()
+		delete(registeredRules, "test-no-test-prefix")
+		rulesMu.Unlock()
+	})
+
+	assert.EqualError(t, RegisterRule(noTestPrefix), `rule "test-no-test-prefix" is already registered`)
+	assert.EqualError(t, RegisterRule(NewRule("", nil)), "rule name must not be empty")
+	assert.Equal(t, []string{"checksum", "exemption", "format", "test-no-test-prefix"}, RegisteredRules())
+
+	rule, ok := LookupRule("test-no-test-prefix")
+	assert.True(t, ok)
+	assert.Equal(t, "test-no-test-prefix", rule.Name())
+
+	chain, err := ChainByName("format", "test-no-test-prefix", "checksum")
+	assert.NoError(t, err)
+
+	result := chain.Validate("TESTBCDEFG")
+	assert.False(t, result.Valid)
+	assert.EqualError(t, result.Err, "test USIs are not allowed")
+	assert.Equal(t, "test-no-test-prefix", result.Rules[len(result.Rules)-1].Rule)
+
+	assert.True(t, chain.Validate("BNGH7C75FN").Valid)
+
+	_, err = ChainByName("format", "missing")
+	assert.EqualError(t, err, `rule "missing" is not registered`)
+}
@@ -0,0 +1,209 @@
+package usivalidator
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"unicode"
+)
+
+// AlgorithmLuhnModN identifies the Luhn Mod N check character algorithm,
+// currently the only one supported.
+const AlgorithmLuhnModN = "luhn-mod-n"
+
+// Scheme describes an identifier format validated with the Luhn Mod N
+// algorithm: its total length (including the check character) and the
+// alphabet the characters are drawn from. Applications can select a scheme by
+// name or detect one from a key, so a future revision of the USI format can be
+// added alongside the current one.
+type Scheme struct {
+	// Name identifies the scheme, e.g. "USI v1".
+	Name string
+	// Length is the full key length, including the check character.
+	Length int
+	// Alphabet lists the valid characters in code point order. They must be
+	// distinct ASCII characters with no lowercase letters, as keys are
+	// compared byte by byte after uppercasing.
+	Alphabet []rune
+	// Algorithm identifies the check character algorithm. It must be AlgorithmLuhnModN.
+	Algorithm string
+}
+
+// USIv1 is the current Unique Student Identifier scheme: 10 characters from
+// ValidCharacters, the last being a Luhn Mod N check character.
+var USIv1 = &Scheme{
+	Name:      "USI v1",
+	Length:    10,
+	Alphabet:  ValidCharacters,
+	Algorithm: AlgorithmLuhnModN,
+}
+
+var (
+	schemesMu sync.RWMutex
+	schemes   = []*Scheme{USIv1}
+)
+
+// RegisterScheme makes s available to LookupScheme, Schemes and DetectScheme.
+// USIv1 is registered by default.
+//
+// Parameters:
+// - s (*Scheme): The scheme to register.
+//
+// Returns:
+// - (error): An error if the scheme is incomplete, its alphabet has lowercase,
+//   non-ASCII or duplicate characters, it uses an unsupported algorithm or its
+//   name is already registered.
+//
+// Usage:
+// err := RegisterScheme(&Scheme{Name: "USI v2", Length: 12, Alphabet: ValidCharacters, Algorithm: AlgorithmLuhnModN})
+
+func RegisterScheme(s *Scheme) error {
+	if s.Name == "" || s.Length < 2 || len(s.Alphabet) < 2 {
+		return errors.New("scheme must have a name, a length of at least 2 and an alphabet of at least 2 characters")
+	}
+	seen := make(map[rune]bool, len(s.Alphabet))
+	for _, char := range s.Alphabet {
+		switch {
+		case char >= 'a' && char <= 'z':
+			return fmt.Errorf("scheme %q: alphabet has lowercase character %q", s.Name, char)
+		case char > unicode.MaxASCII:
+			return fmt.Errorf("scheme %q: alphabet has non-ASCII character %q", s.Name, char)
+		case seen[char]:
+			return fmt.Errorf("scheme %q: alphabet has duplicate character %q", s.Name, char)
+		}
+		seen[char] = true
+	}
+	if s.Algorithm != AlgorithmLuhnModN {
+		return fmt.Errorf("scheme %q: unsupported algorithm %q", s.Name, s.Algorithm)
+	}
+
+	schemesMu.Lock()
+	defer schemesMu.Unlock()
+
+	for _, existing := range schemes {
+		if existing.Name == s.Name {
+			return fmt.Errorf("scheme %q is already registered", s.Name)
+		}
+	}
+	schemes = append(schemes, s)
+	return nil
+}
+
+// LookupScheme returns the registered scheme with the given name.
+func LookupScheme(name string) (*Scheme, bool) {
+	schemesMu.RLock()
+	defer schemesMu.RUnlock()
+
+	for _, s := range schemes {
+		if s.Name == name {
+			return s, true
+		}
+	}
+	return nil, false
+}
+
+// Schemes returns all registered schemes in registration order.
+func Schemes() []*Scheme {
+	schemesMu.RLock()
+	defer schemesMu.RUnlock()
+
+	return append([]*Scheme(nil), schemes...)
+}
+
+// DetectScheme returns the first registered scheme whose length and alphabet
+// fit key. The check character is not verified.
+//
+// Parameters:
+// - key (string): The identifier to inspect.
+//
+// Returns:
+// - (*Scheme): The matching scheme.
+// - (bool): False if no registered scheme fits key.
+//
+// Usage:
+// if scheme, ok := DetectScheme("BNGH7C75FN"); ok {
+//     fmt.Println(scheme.Name) // USI v1
+// }
+
+func DetectScheme(key string) (*Scheme, bool) {
+	key = upperASCII(key)
+	for _, s := range Schemes() {
+		if len(key) == s.Length && s.fits(key) {
+			return s, true
+		}
+	}
+	return nil, false
+}
+
+// GenerateCheckCharacter calculates the check character for a prefix of
+// s.Length-1 characters using the Luhn Mod N algorithm over s.Alphabet.
+//
+// Parameters:
+// - input (string): The key without its check character.
+//
+// Returns:
+// - (rune): The calculated check character.
+// - (error): A *ValidationError if the input length is wrong or it contains invalid characters.
+
+func (s *Scheme) GenerateCheckCharacter(input string) (rune, error) {
+	if len(input) != s.Length-1 {
+		return ' ', lengthError(len(input), "input length must be %d characters", s.Length-1)
+	}
+
+	factor := 2
+	sum := 0
+	n := len(s.Alphabet)
+
+	for i := len(input) - 1; i >= 0; i-- {
+		char := rune(input[i])
+		codePoint := indexOf(char, s.Alphabet)
+		if codePoint == -1 {
+			return ' ', characterError(input, s.Alphabet)
+		}
+
+		addend := factor * codePoint
+		factor = alternateFactor(factor)
+		addend = (addend / n) + (addend % n)
+		sum += addend
+	}
+
+	remainder := sum % n
+	checkCodePoint := (n - remainder) % n
+
+	return s.Alphabet[checkCodePoint], nil
+}
+
+// VerifyKey validates key against its check character. Case is ignored.
+// A Scheme is a Verifier.
+//
+// Parameters:
+// - key (string): The key to validate. Must be exactly s.Length characters long.
+//
+// Returns:
+// - (bool): True if the key is valid, false otherwise.
+// - (error): An error if the input length is invalid or contains invalid characters.
+
+func (s *Scheme) VerifyKey(key string) (bool, error) {
+	if len(key) != s.Length {
+		return false, lengthError(len(key), "key length must be %d characters", s.Length)
+	}
+
+	key = upperASCII(key)
+	checkChar, err := s.GenerateCheckCharacter(key[:s.Length-1])
+	if err != nil {
+		// Locate the character in the full key: a multibyte character can
+		// straddle the end of the byte-sliced prefix.
+		return false, characterError(key, s.Alphabet)
+	}
+
+	return rune(key[s.Length-1]) == checkChar, nil
+}
+
+func (s *Scheme) fits(key string) bool {
+	for _, char := range key {
+		if indexOf(char, s.Alphabet) == -1 {
+			return false
+		}
+	}
+	return true
+}
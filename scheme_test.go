@@ -0,0 +1,79 @@
+package usivalidator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUSIv1(t *testing.T) {
+	scheme, ok := LookupScheme("USI v1")
+	assert.True(t, ok)
+	assert.Same(t, USIv1, scheme)
+	assert.Equal(t, 10, scheme.Length)
+	assert.Equal(t, ValidCharacters, scheme.Alphabet)
+	assert.Equal(t, "luhn-mod-n", scheme.Algorithm)
+
+	isValid, err := scheme.VerifyKey("bngh7c75fn")
+	assert.True(t, isValid)
+	assert.NoError(t, err)
+
+	isValid, err = scheme.VerifyKey("BNGH7C75FX")
+	assert.False(t, isValid)
+	assert.NoError(t, err)
+
+	_, err = scheme.VerifyKey("BNGH7C75F")
+	assert.EqualError(t, err, "key length must be 10 characters")
+}
+
+func TestRegisterScheme(t *testing.T) {
+	hex := &Scheme{Name: "test hex", Length: 6, Alphabet: []rune("0123456789ABCDEF"), Algorithm: "luhn-mod-n"}
+	assert.NoError(t, RegisterScheme(hex))
+	t.Cleanup(func() {
+		schemesMu.Lock()
+		schemes = schemes[:len(schemes)-1]
+		schemesMu.Unlock()
+	})
+
+	assert.EqualError(t, RegisterScheme(hex), `scheme "test hex" is already registered`)
+	assert.Error(t, RegisterScheme(&Scheme{Name: "incomplete"}))
+	assert.EqualError(t, RegisterScheme(&Scheme{Name: "damm", Length: 6, Alphabet: []rune("0123456789"), Algorithm: "damm"}),
+		`scheme "damm": unsupported algorithm "damm"`)
+	assert.Error(t, RegisterScheme(&Scheme{Name: "no algorithm", Length: 6, Alphabet: []rune("0123456789")}))
+	assert.EqualError(t, RegisterScheme(&Scheme{Name: "lower", Length: 6, Alphabet: []rune("0123abcd"), Algorithm: AlgorithmLuhnModN}),
+		`scheme "lower": alphabet has lowercase character 'a'`)
+	assert.EqualError(t, RegisterScheme(&Scheme{Name: "accents", Length: 6, Alphabet: []rune("ABCDÉ"), Algorithm: AlgorithmLuhnModN}),
+		`scheme "accents": alphabet has non-ASCII character 'É'`)
+	assert.EqualError(t, RegisterScheme(&Scheme{Name: "duplicates", Length: 6, Alphabet: []rune("AAB"), Algorithm: AlgorithmLuhnModN}),
+		`scheme "duplicates": alphabet has duplicate character 'A'`)
+	assert.Equal(t, []*Scheme{USIv1, hex}, Schemes())
+
+	checkChar, err := hex.GenerateCheckCharacter("1A2B3")
+	assert.NoError(t, err)
+	isValid, err := hex.VerifyKey("1A2B3" + string(checkChar))
+	assert.True(t, isValid)
+	assert.NoError(t, err)
+
+	_, err = hex.GenerateCheckCharacter("1A2B")
+	assert.EqualError(t, err, "input length must be 5 characters")
+
+	testCases := []struct {
+		Key      string
+		Expected *Scheme
+		TestName string
+	}{
+		{"BNGH7C75FN", USIv1, "USI"},
+		{"bngh7c75fn", USIv1, "Lowercase USI"},
+		{"1A2B3C", hex, "Registered scheme"},
+		{"BNGH7C75F", nil, "No scheme with this length"},
+		{"1A2B3G", nil, "Character outside alphabet"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			scheme, ok := DetectScheme(tc.Key)
+			assert.Equal(t, tc.Expected != nil, ok)
+			assert.Equal(t, tc.Expected, scheme)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package usivalidator
+
+import (
+	"errors"
+	"fmt"
+)
+
+// canonicalAlphabet is the USI alphabet that ValidCharacters must hold.
+const canonicalAlphabet = "23456789ABCDEFGHJKLMNPQRSTUVWXYZ"
+
+// SelfTest verifies at runtime that the lookup tables (including those of the
+// fast kernel) have not been modified and that the embedded conformance
+// vectors pass. It is intended to be called at service startup in locked-down
+// environments where the binary may have been patched or stripped.
+//
+// Returns:
+// - (error): nil if every check passes, otherwise an error describing each failure.
+//
+// Usage:
+// if err := SelfTest(); err != nil {
+//     log.Fatal("usivalidator self-test failed: ", err)
+// }
+
+func SelfTest() error {
+	var failures []error
+
+	if string(ValidCharacters) != canonicalAlphabet {
+		failures = append(failures, fmt.Errorf("self-test: ValidCharacters is %q, expected %q", string(ValidCharacters), canonicalAlphabet))
+	}
+	if string(USIv1.Alphabet) != canonicalAlphabet || USIv1.Length != 10 {
+		failures = append(failures, errors.New("self-test: USIv1 scheme has been modified"))
+	}
+	for i, char := range canonicalAlphabet {
+		if got := indexOf(char, ValidCharacters); got != i {
+			failures = append(failures, fmt.Errorf("self-test: index of %q is %d, expected %d", char, got, i))
+		}
+	}
+
+	if !kernelIntact() {
+		failures = append(failures, errors.New("self-test: fast kernel tables do not match ValidCharacters"))
+	}
+
+	if err := RunConformance(); err != nil {
+		failures = append(failures, err)
+	}
+	return errors.Join(failures...)
+}
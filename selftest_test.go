@@ -0,0 +1,24 @@
+package usivalidator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelfTest(t *testing.T) {
+	assert.NoError(t, SelfTest())
+}
+
+func TestSelfTestDetectsTampering(t *testing.T) {
+	original := ValidCharacters[0]
+	t.Cleanup(func() { ValidCharacters[0] = original })
+
+	ValidCharacters[0] = '0'
+	err := SelfTest()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "self-test: ValidCharacters is")
+	assert.Contains(t, err.Error(), "self-test: USIv1 scheme has been modified")
+	assert.Contains(t, err.Error(), "self-test: fast kernel tables do not match ValidCharacters")
+	assert.Contains(t, err.Error(), "conformance:")
+}
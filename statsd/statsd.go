@@ -0,0 +1,77 @@
+/*
+Package statsd is a usivalidator.MetricsSink that sends measurements to a
+StatsD or DogStatsD agent over UDP. Tags are sent in the DogStatsD format and
+are ignored by plain StatsD servers.
+*/
+package statsd
+
+import (
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Sink sends metrics to a StatsD agent. Sends are fire-and-forget: write errors
+// are dropped so that metrics can never fail a verification.
+type Sink struct {
+	conn   net.Conn
+	prefix string
+}
+
+// New connects to the agent at addr, e.g. "127.0.0.1:8125".
+//
+// Parameters:
+// - addr (string): The UDP address of the agent.
+// - prefix (string): Prepended to every metric name, e.g. "enrolments." (may be empty).
+//
+// Returns:
+// - (*Sink): The sink.
+// - (error): An error if the address cannot be resolved.
+//
+// Usage:
+// sink, err := statsd.New("127.0.0.1:8125", "enrolments.")
+// if err != nil {
+//     log.Fatal(err)
+// }
+// defer sink.Close()
+// verifier := usivalidator.NewInstrumentedVerifier(usivalidator.DefaultVerifier, sink)
+
+func New(addr, prefix string) (*Sink, error) {
+	conn, err := net.Dial("udp", addr)
+	if err != nil {
+		return nil, err
+	}
+	return &Sink{conn: conn, prefix: prefix}, nil
+}
+
+// IncCounter sends a counter ("c") metric.
+func (s *Sink) IncCounter(name string, value int64, tags ...string) {
+	s.send(name, strconv.FormatInt(value, 10), "c", tags)
+}
+
+// ObserveDuration sends a timing ("ms") metric.
+func (s *Sink) ObserveDuration(name string, d time.Duration, tags ...string) {
+	ms := strconv.FormatFloat(float64(d)/float64(time.Millisecond), 'f', -1, 64)
+	s.send(name, ms, "ms", tags)
+}
+
+// Close closes the connection to the agent.
+func (s *Sink) Close() error {
+	return s.conn.Close()
+}
+
+func (s *Sink) send(name, value, kind string, tags []string) {
+	var b strings.Builder
+	b.WriteString(s.prefix)
+	b.WriteString(name)
+	b.WriteByte(':')
+	b.WriteString(value)
+	b.WriteByte('|')
+	b.WriteString(kind)
+	if len(tags) > 0 {
+		b.WriteString("|#")
+		b.WriteString(strings.Join(tags, ","))
+	}
+	s.conn.Write([]byte(b.String()))
+}
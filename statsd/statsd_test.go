@@ -0,0 +1,45 @@
+package statsd
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/chrisjoyce911/usivalidator"
+	"github.com/stretchr/testify/assert"
+)
+
+var _ usivalidator.MetricsSink = (*Sink)(nil)
+
+func TestSink(t *testing.T) {
+	server, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Skip("UDP not available:", err)
+	}
+	defer server.Close()
+
+	sink, err := New(server.LocalAddr().String(), "test.")
+	assert.NoError(t, err)
+	defer sink.Close()
+
+	testCases := []struct {
+		Send     func()
+		Expected string
+		TestName string
+	}{
+		{func() { sink.IncCounter("usi.verify", 1, "result:valid") }, "test.usi.verify:1|c|#result:valid", "Counter with tag"},
+		{func() { sink.IncCounter("usi.cache.hit", 3) }, "test.usi.cache.hit:3|c", "Counter without tags"},
+		{func() { sink.ObserveDuration("usi.verify.duration", 1500*time.Microsecond, "a:b", "c:d") }, "test.usi.verify.duration:1.5|ms|#a:b,c:d", "Timing"},
+	}
+
+	buf := make([]byte, 512)
+	for _, tc := range testCases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			tc.Send()
+			server.SetReadDeadline(time.Now().Add(time.Second))
+			n, _, err := server.ReadFrom(buf)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.Expected, string(buf[:n]))
+		})
+	}
+}
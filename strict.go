@@ -0,0 +1,32 @@
+package usivalidator
+
+// WithStrictCase makes VerifyKey reject USIs that are not in canonical
+// uppercase with a *ValidationError matching ErrLowercase, instead of
+// uppercasing them. This lets data-quality checks flag records that were
+// stored in the wrong case.
+//
+// Returns:
+// - (Option): The option to pass to VerifyKey.
+//
+// Usage:
+// _, err := VerifyKey("bngh7c75fn", WithStrictCase())
+// if errors.Is(err, ErrLowercase) {
+//     fmt.Println("The USI is not stored in uppercase!")
+// }
+
+func WithStrictCase() Option {
+	return func(o *options) {
+		o.strictCase = true
+	}
+}
+
+// lowercaseError reports the first lowercase letter in key whose uppercase
+// form is a valid character. Other characters are left to the usual checks.
+func lowercaseError(key string) error {
+	for i := 0; i < len(key); i++ {
+		if c := key[i]; c >= 'a' && c <= 'z' && indexOf(rune(c-'a'+'A'), ValidCharacters) != -1 {
+			return &ValidationError{Reason: ReasonLowercase, Index: i, Char: rune(c), msg: "usi must be uppercase"}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package usivalidator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithStrictCase(t *testing.T) {
+	testCases := []struct {
+		Key      string
+		Expected bool
+		Error    error
+		Index    int
+		TestName string
+	}{
+		{"BNGH7C75FN", true, nil, 0, "Uppercase"},
+		{"bngh7c75fn", false, ErrLowercase, 0, "Lowercase"},
+		{"BNGH7c75FN", false, ErrLowercase, 5, "Single lowercase character"},
+		{"BNGH7C7oFN", false, ErrInvalidCharacter, 7, "Lowercase invalid character"},
+		{"BNGH7C75FM", false, nil, 0, "Wrong check character"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			for _, opts := range [][]Option{{WithStrictCase()}, {WithStrictCase(), WithFastKernel()}} {
+				isValid, err := VerifyKey(tc.Key, opts...)
+				assert.Equal(t, tc.Expected, isValid)
+				if tc.Error == nil {
+					assert.NoError(t, err)
+					continue
+				}
+				assert.ErrorIs(t, err, tc.Error)
+				var e *ValidationError
+				if assert.ErrorAs(t, err, &e) {
+					assert.Equal(t, tc.Index, e.Index)
+				}
+			}
+		})
+	}
+}
+
+func TestWithStrictCaseAndLenient(t *testing.T) {
+	isValid, err := VerifyKey(" BNGH-7C75-FN ", WithLenient(), WithStrictCase())
+	assert.True(t, isValid)
+	assert.NoError(t, err)
+
+	_, err = VerifyKey(" bngh-7c75-fn ", WithLenient(), WithStrictCase())
+	assert.ErrorIs(t, err, ErrLowercase)
+}
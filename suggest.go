@@ -0,0 +1,138 @@
+package usivalidator
+
+import "fmt"
+
+// confusables maps characters to the valid characters they are commonly
+// mistaken for when read from handwriting, print or OCR. Characters outside
+// the alphabet (0, 1, I, O) map to their closest valid look-alikes.
+var confusables = map[rune][]rune{
+	'0': {'D', 'Q'},
+	'O': {'D', 'Q'},
+	'D': {'Q'},
+	'Q': {'D'},
+	'1': {'L', '7'},
+	'I': {'L', 'J'},
+	'L': {'J'},
+	'J': {'L'},
+	'2': {'Z'},
+	'Z': {'2'},
+	'5': {'S'},
+	'S': {'5'},
+	'6': {'G'},
+	'G': {'6'},
+	'8': {'B'},
+	'B': {'8'},
+	'U': {'V'},
+	'V': {'U'},
+}
+
+// SuggestionError wraps a verification failure with a high-confidence
+// correction. It is returned by VerifyKey when WithSuggestions is set.
+type SuggestionError struct {
+	// Err is the underlying failure.
+	Err error
+	// Suggestion is the corrected, valid USI.
+	Suggestion string
+}
+
+// Error describes the failure and the suggested correction.
+func (e *SuggestionError) Error() string {
+	return fmt.Sprintf("%v (did you mean %s?)", e.Err, e.Suggestion)
+}
+
+// Unwrap returns the underlying failure.
+func (e *SuggestionError) Unwrap() error {
+	return e.Err
+}
+
+// Suggest looks for a single-edit correction of an invalid 10-character USI:
+// replacing one character with a common look-alike, or swapping two adjacent
+// characters. A suggestion is only made when exactly one such edit yields a
+// valid USI.
+//
+// Parameters:
+// - key (string): The invalid USI.
+//
+// Returns:
+// - (string): The corrected USI.
+// - (bool): False if key is already valid or no unambiguous correction exists.
+//
+// Usage:
+// if suggestion, ok := Suggest("BNGH7C7SFN"); ok {
+//     fmt.Println("Did you mean", suggestion) // BNGH7C75FN
+// }
+
+func Suggest(key string) (string, bool) {
+	if len(key) != 10 {
+		return "", false
+	}
+	key = upperASCII(key)
+	if isValid, err := VerifyKey(key); isValid && err == nil {
+		return "", false
+	}
+
+	candidates := make(map[string]bool)
+	try := func(candidate []byte) {
+		if isValid, err := VerifyKey(string(candidate)); isValid && err == nil {
+			candidates[string(candidate)] = true
+		}
+	}
+
+	for i := 0; i < len(key); i++ {
+		for _, replacement := range confusables[rune(key[i])] {
+			candidate := []byte(key)
+			candidate[i] = byte(replacement)
+			try(candidate)
+		}
+		if i < len(key)-1 && key[i] != key[i+1] {
+			candidate := []byte(key)
+			candidate[i], candidate[i+1] = candidate[i+1], candidate[i]
+			try(candidate)
+		}
+	}
+
+	if len(candidates) != 1 {
+		return "", false
+	}
+	for candidate := range candidates {
+		return candidate, true
+	}
+	return "", false
+}
+
+// WithSuggestions makes VerifyKey return a *SuggestionError when a USI is
+// invalid but Suggest finds a high-confidence correction. A wrong check
+// character, which is otherwise reported as (false, nil), is then reported as
+// an error wrapping the check character mismatch.
+//
+// Returns:
+// - (Option): The option to pass to VerifyKey.
+//
+// Usage:
+// _, err := VerifyKey("BNGH7C7SFN", WithSuggestions())
+// var suggestion *SuggestionError
+// if errors.As(err, &suggestion) {
+//     fmt.Println("Did you mean", suggestion.Suggestion)
+// }
+
+func WithSuggestions() Option {
+	return func(o *options) {
+		o.suggestions = true
+	}
+}
+
+// suggest wraps err, or a check character mismatch if err is nil, with a
+// suggested correction for key when suggestions are enabled and one exists.
+func (o options) suggest(key string, err error) error {
+	if !o.suggestions {
+		return err
+	}
+	suggestion, ok := Suggest(key)
+	if !ok {
+		return err
+	}
+	if err == nil {
+		err = mismatchError(key)
+	}
+	return &SuggestionError{Err: err, Suggestion: suggestion}
+}
@@ -0,0 +1,74 @@
+package usivalidator
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func ExampleWithSuggestions() {
+	_, err := VerifyKey("BNGH7C7SFN", WithSuggestions())
+
+	var suggestion *SuggestionError
+	if errors.As(err, &suggestion) {
+		fmt.Println("Did you mean", suggestion.Suggestion)
+	}
+
+	// Output: Did you mean BNGH7C75FN
+}
+
+func TestSuggest(t *testing.T) {
+	testCases := []struct {
+		Key        string
+		Suggestion string
+		TestName   string
+	}{
+		{"BNGH7C7SFN", "BNGH7C75FN", "S read for 5"},
+		{"8NGH7C75FN", "BNGH7C75FN", "8 read for B"},
+		{"bngh7c7sfn", "BNGH7C75FN", "Lowercase input"},
+		{"UGQ8JN6UD9", "U6Q8JN6UD9", "G read for 6"},
+		{"POGGW5XLXW", "PDGGW5XLXW", "Invalid character O read for D"},
+		{"BNHG7C75FN", "BNGH7C75FN", "Adjacent transposition"},
+		{"BNGH7C75NF", "BNGH7C75FN", "Transposed check character"},
+		{"BNGH7C75FN", "", "Already valid"},
+		{"BNGH7C75FX", "", "No look-alike correction"},
+		{"BNGH7C75F", "", "Wrong length"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			suggestion, ok := Suggest(tc.Key)
+			assert.Equal(t, tc.Suggestion != "", ok)
+			assert.Equal(t, tc.Suggestion, suggestion)
+		})
+	}
+}
+
+func TestVerifyKeyWithSuggestions(t *testing.T) {
+	isValid, err := VerifyKey("BNGH7C7SFN", WithSuggestions())
+	assert.False(t, isValid)
+	assert.EqualError(t, err, "check character mismatch (did you mean BNGH7C75FN?)")
+
+	var suggestion *SuggestionError
+	assert.True(t, errors.As(err, &suggestion))
+	assert.Equal(t, "BNGH7C75FN", suggestion.Suggestion)
+	assert.True(t, errors.Is(err, ErrCheckCharMismatch))
+
+	isValid, err = VerifyKey("POGGW5XLXW", WithSuggestions())
+	assert.False(t, isValid)
+	assert.EqualError(t, err, "invalid character in input (did you mean PDGGW5XLXW?)")
+
+	isValid, err = VerifyKey("BNGH7C75FX", WithSuggestions())
+	assert.False(t, isValid)
+	assert.NoError(t, err, "Without a suggestion a wrong check character is not an error")
+
+	isValid, err = VerifyKey("BNGH7C7SFN")
+	assert.False(t, isValid)
+	assert.NoError(t, err, "Suggestions are opt-in")
+
+	isValid, err = VerifyKey("BNGH7C75FN", WithSuggestions())
+	assert.True(t, isValid)
+	assert.NoError(t, err)
+}
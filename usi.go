@@ -0,0 +1,122 @@
+package usivalidator
+
+import (
+	"encoding/binary"
+)
+
+// USI is a Unique Student Identifier.
+//
+// A USI marshals to 8 big-endian bytes using the compact Encode form, so the
+// binary encoding sorts in the same order as the identifiers themselves. This
+// makes it suitable as a key in ordered stores such as bolt, badger or other
+// LSM-based databases.
+type USI string
+
+// MarshalBinary encodes u as 8 big-endian bytes.
+//
+// Returns:
+// - ([]byte): The encoded USI.
+// - (error): An error if u has an invalid length or contains invalid characters.
+//
+// Usage:
+// data, err := USI("BNGH7C75FN").MarshalBinary()
+
+func (u USI) MarshalBinary() ([]byte, error) {
+	value, err := Encode(string(u))
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]byte, 8)
+	binary.BigEndian.PutUint64(data, value)
+	return data, nil
+}
+
+// UnmarshalBinary decodes 8 bytes produced by MarshalBinary into u.
+//
+// Parameters:
+// - data ([]byte): The encoded USI. Must be exactly 8 bytes long.
+//
+// Returns:
+// - (error): An error if data is not 8 bytes long.
+//
+// Usage:
+// var u USI
+// err := u.UnmarshalBinary(data)
+
+func (u *USI) UnmarshalBinary(data []byte) error {
+	if len(data) != 8 {
+		return newError(ErrInvalidLength, "binary USI must be 8 bytes")
+	}
+
+	*u = USI(Decode(binary.BigEndian.Uint64(data)))
+	return nil
+}
+
+// MarshalText returns the canonical uppercase form of u.
+//
+// Returns:
+// - ([]byte): The USI text.
+// - (error): An error if u has an invalid length or contains invalid characters.
+//
+// Usage:
+// text, err := USI("bngh7c75fn").MarshalText() // "BNGH7C75FN"
+
+func (u USI) MarshalText() ([]byte, error) {
+	if _, err := Encode(string(u)); err != nil {
+		return nil, err
+	}
+	return []byte(upperASCII(string(u))), nil
+}
+
+// UnmarshalText parses text into u, converting it to canonical uppercase form.
+//
+// Parameters:
+// - text ([]byte): The USI text. Must be exactly 10 characters long.
+//
+// Returns:
+// - (error): An error if the text has an invalid length or contains invalid characters.
+//
+// Usage:
+// var u USI
+// err := u.UnmarshalText([]byte("BNGH7C75FN"))
+
+func (u *USI) UnmarshalText(text []byte) error {
+	if _, err := Encode(string(text)); err != nil {
+		return err
+	}
+
+	*u = USI(upperASCII(string(text)))
+	return nil
+}
+
+// Valid reports whether u is a valid USI. Case is ignored.
+func (u USI) Valid() bool {
+	isValid, err := VerifyKey(string(u))
+	return isValid && err == nil
+}
+
+// Prefix returns the first 9 characters of u in uppercase, or "" if u is not
+// 10 characters long.
+func (u USI) Prefix() string {
+	if len(u) != 10 {
+		return ""
+	}
+	return upperASCII(string(u[:9]))
+}
+
+// CheckChar returns the check character calculated from u's prefix, which
+// equals the last character of u when u is valid. It returns 0 if the prefix
+// is malformed.
+func (u USI) CheckChar() rune {
+	checkChar, err := GenerateCheckCharacter(u.Prefix())
+	if err != nil {
+		return 0
+	}
+	return checkChar
+}
+
+// String returns u in canonical uppercase form.
+func (u USI) String() string {
+	return upperASCII(string(u))
+}
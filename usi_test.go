@@ -0,0 +1,144 @@
+package usivalidator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUSIMarshalBinary(t *testing.T) {
+	testCases := []struct {
+		USI           USI
+		Expected      []byte
+		ExpectedError string
+	}{
+		{"2222222222", []byte{0, 0, 0, 0, 0, 0, 0, 0}, ""},
+		{"2222222223", []byte{0, 0, 0, 0, 0, 0, 0, 1}, ""},
+		{"ZZZZZZZZZZ", []byte{0, 3, 255, 255, 255, 255, 255, 255}, ""},
+		{"BNGH7C75F", nil, "key length must be 10 characters"},
+		{"BNGH7C75F!", nil, "invalid character in input"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(string(tc.USI), func(t *testing.T) {
+			data, err := tc.USI.MarshalBinary()
+			if tc.ExpectedError != "" {
+				assert.EqualError(t, err, tc.ExpectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.Expected, data)
+
+			var decoded USI
+			assert.NoError(t, decoded.UnmarshalBinary(data))
+			assert.Equal(t, tc.USI, decoded)
+		})
+	}
+}
+
+func TestUSIUnmarshalBinaryInvalidLength(t *testing.T) {
+	var u USI
+	assert.EqualError(t, u.UnmarshalBinary([]byte{1, 2, 3}), "binary USI must be 8 bytes")
+}
+
+func TestUSIMarshalBinarySortOrder(t *testing.T) {
+	usis := []string{"U6Q8JN6UD9", "BNGH7C75FN", "DG6K5YHPP3", "RVJ5DM8LXJ", "BP6LKB3C7X", "PDGGW5XLXW"}
+
+	encoded := make([][]byte, len(usis))
+	for i, usi := range usis {
+		encoded[i], _ = USI(usi).MarshalBinary()
+	}
+
+	sort.Strings(usis)
+	sort.Slice(encoded, func(i, j int) bool { return bytes.Compare(encoded[i], encoded[j]) < 0 })
+	for i, data := range encoded {
+		var u USI
+		assert.NoError(t, u.UnmarshalBinary(data))
+		assert.Equal(t, USI(usis[i]), u)
+	}
+}
+
+func TestUSIText(t *testing.T) {
+	testCases := []struct {
+		Input         string
+		Expected      USI
+		ExpectedError string
+	}{
+		{"BNGH7C75FN", "BNGH7C75FN", ""},
+		{"bngh7c75fn", "BNGH7C75FN", ""},
+		{"BNGH7C75F", "", "key length must be 10 characters"},
+		{"BNGH7C75F1", "", "invalid character in input"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Input, func(t *testing.T) {
+			var u USI
+			err := u.UnmarshalText([]byte(tc.Input))
+			if tc.ExpectedError != "" {
+				assert.EqualError(t, err, tc.ExpectedError)
+
+				_, err = USI(tc.Input).MarshalText()
+				assert.EqualError(t, err, tc.ExpectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.Expected, u)
+
+			text, err := USI(tc.Input).MarshalText()
+			assert.NoError(t, err)
+			assert.Equal(t, string(tc.Expected), string(text))
+		})
+	}
+}
+
+func TestUSIJSON(t *testing.T) {
+	var record struct {
+		USI USI `json:"usi"`
+	}
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"usi":"bngh7c75fn"}`), &record))
+	assert.Equal(t, USI("BNGH7C75FN"), record.USI)
+
+	data, err := json.Marshal(record)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"usi":"BNGH7C75FN"}`, string(data))
+
+	assert.Error(t, json.Unmarshal([]byte(`{"usi":"short"}`), &record))
+}
+
+func TestUSIMethods(t *testing.T) {
+	testCases := []struct {
+		USI       USI
+		Valid     bool
+		Prefix    string
+		CheckChar rune
+		String    string
+		TestName  string
+	}{
+		{"BNGH7C75FN", true, "BNGH7C75F", 'N', "BNGH7C75FN", "Valid USI"},
+		{"bngh7c75fn", true, "BNGH7C75F", 'N', "BNGH7C75FN", "Lowercase USI"},
+		{"BNGH7C75FM", false, "BNGH7C75F", 'N', "BNGH7C75FM", "Wrong check character"},
+		{"BNGH7C7OFN", false, "BNGH7C7OF", 0, "BNGH7C7OFN", "Invalid character"},
+		{"BNGH7C75F", false, "", 0, "BNGH7C75F", "Too short"},
+		{"ſNGH7C75FN", false, "", 0, "ſNGH7C75FN", "Non-ASCII letter is not uppercased"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			assert.Equal(t, tc.Valid, tc.USI.Valid())
+			assert.Equal(t, tc.Prefix, tc.USI.Prefix())
+			assert.Equal(t, tc.CheckChar, tc.USI.CheckChar())
+			assert.Equal(t, tc.String, tc.USI.String())
+		})
+	}
+}
+
+func ExampleUSI() {
+	u := USI("bngh7c75fn")
+	fmt.Println(u, u.Valid(), u.Prefix(), string(u.CheckChar()))
+	// Output: BNGH7C75FN true BNGH7C75F N
+}
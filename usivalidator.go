@@ -10,11 +10,6 @@ This package implements the validation process using the Luhn Mod N algorithm, w
 */
 package usivalidator
 
-import (
-	"errors"
-	"strings"
-)
-
 // ValidCharacters contains the valid characters for the USI
 var ValidCharacters = []rune{'2', '3', '4', '5', '6', '7', '8', '9',
 	'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H',
@@ -25,10 +20,13 @@ var ValidCharacters = []rune{'2', '3', '4', '5', '6', '7', '8', '9',
 //
 // Parameters:
 // - key (string): The USI to validate. Must be exactly 10 characters long.
+// - opts (...Option): Optional checks applied after the check character, such as WithBlocklist.
 //
 // Returns:
 // - (bool): True if the USI is valid, false otherwise.
-// - (error): An error if the input length is invalid or contains invalid characters.
+// - (error): A *ValidationError matching ErrInvalidLength or ErrInvalidCharacter if the input is malformed,
+//   or ErrBlocked/ErrNotAllowed if an option rejects an otherwise valid USI.
+//   With WithSuggestions, failures with a likely correction are returned as *SuggestionError.
 //
 // Usage:
 // isValid, err := VerifyKey("BNGH7C75FN")
@@ -40,18 +38,45 @@ var ValidCharacters = []rune{'2', '3', '4', '5', '6', '7', '8', '9',
 //     fmt.Println("The USI is invalid!")
 // }
 
-func VerifyKey(key string) (bool, error) {
+func VerifyKey(key string, opts ...Option) (bool, error) {
+	return verifyKey(key, newOptions(opts))
+}
+
+// verifyKey implements VerifyKey with options that have already been applied.
+func verifyKey(key string, o options) (bool, error) {
+	if o.lenient {
+		key = StripSeparators(key)
+	}
 	if len(key) != 10 {
-		return false, errors.New("key length must be 10 characters")
+		return false, lengthError(len(key), "key length must be 10 characters")
+	}
+	if o.strictCase {
+		if err := lowercaseError(key); err != nil {
+			return false, err
+		}
 	}
 
-	key = strings.ToUpper(key)
+	if o.fastKernel {
+		if valid, wellFormed := kernelVerify(key); wellFormed {
+			if !valid {
+				return false, o.suggest(key, nil)
+			}
+			return o.checkLists(key)
+		}
+	}
+
+	key = upperASCII(key)
 	checkDigit, err := GenerateCheckCharacter(key[:9])
 	if err != nil {
-		return false, err
+		// Locate the character in the full key: a multibyte character can
+		// straddle the end of the byte-sliced prefix.
+		return false, o.suggest(key, characterError(key, ValidCharacters))
+	}
+	if rune(key[9]) != checkDigit {
+		return false, o.suggest(key, nil)
 	}
 
-	return rune(key[9]) == checkDigit, nil
+	return o.checkLists(key)
 }
 
 // GenerateCheckCharacter calculates the check character for a 9-character USI prefix
@@ -62,7 +87,7 @@ func VerifyKey(key string) (bool, error) {
 //
 // Returns:
 // - (rune): The calculated check character.
-// - (error): An error if the input length is not 9 characters or contains invalid characters.
+// - (error): A *ValidationError if the input length is not 9 characters or contains invalid characters.
 //
 // Usage:
 // checkChar, err := GenerateCheckCharacter("BNGH7C75F")
@@ -73,31 +98,36 @@ func VerifyKey(key string) (bool, error) {
 // }
 
 func GenerateCheckCharacter(input string) (rune, error) {
-	if len(input) != 9 {
-		return ' ', errors.New("input length must be 9 characters")
-	}
-
-	factor := 2
-	sum := 0
-	n := len(ValidCharacters)
+	return USIv1.GenerateCheckCharacter(input)
+}
 
-	for i := len(input) - 1; i >= 0; i-- {
-		char := rune(input[i])
-		codePoint := indexOf(char, ValidCharacters)
-		if codePoint == -1 {
-			return ' ', errors.New("invalid character in input")
+// upperASCII converts the ASCII letters of s to uppercase and leaves all other
+// bytes unchanged. Unlike strings.ToUpper it never changes the length of s, so
+// a length checked before the conversion still holds afterwards; non-ASCII
+// characters are then rejected as invalid.
+//
+// Parameters:
+// - s (string): The input to convert.
+//
+// Returns:
+// - (string): s with a-z replaced by A-Z.
+//
+// Usage:
+// key = upperASCII("bngh7c75fn") // "BNGH7C75FN"
+
+func upperASCII(s string) string {
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; c >= 'a' && c <= 'z' {
+			b := []byte(s)
+			for j := i; j < len(b); j++ {
+				if b[j] >= 'a' && b[j] <= 'z' {
+					b[j] -= 'a' - 'A'
+				}
+			}
+			return string(b)
 		}
-
-		addend := factor * codePoint
-		factor = alternateFactor(factor)
-		addend = (addend / n) + (addend % n)
-		sum += addend
 	}
-
-	remainder := sum % n
-	checkCodePoint := (n - remainder) % n
-
-	return ValidCharacters[checkCodePoint], nil
+	return s
 }
 
 // indexOf finds the index of a rune in a slice of runes.
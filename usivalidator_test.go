@@ -146,3 +146,31 @@ func TestAlternateFactor(t *testing.T) {
 		})
 	}
 }
+
+func TestVerifyKeyNonASCII(t *testing.T) {
+	// 'ſ' is two bytes, so these keys pass the byte length check, and
+	// uppercasing it gives the one-byte 'S'.
+	for _, key := range []string{"ſNGH7C75F", "bngh7c7ſn"} {
+		isValid, err := VerifyKey(key)
+		assert.False(t, isValid, key)
+		assert.ErrorIs(t, err, ErrInvalidCharacter, key)
+
+		assert.False(t, USI(key).Valid(), key)
+		_, err = ChecksumRule().Check(key)
+		assert.ErrorIs(t, err, ErrInvalidCharacter, key)
+		_, err = USIv1.VerifyKey(key)
+		assert.ErrorIs(t, err, ErrInvalidCharacter, key)
+	}
+}
+
+func TestVerifyKeyMultibyteAtPrefixEnd(t *testing.T) {
+	// 'é' occupies bytes 8 and 9, straddling the end of the 9-byte prefix.
+	for _, verify := range []func(string) (bool, error){DefaultVerifier.VerifyKey, USIv1.VerifyKey} {
+		_, err := verify("BNGH7C75é")
+
+		var validationErr *ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, 8, validationErr.Index)
+		assert.Equal(t, 'é', validationErr.Char)
+	}
+}
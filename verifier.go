@@ -0,0 +1,19 @@
+package usivalidator
+
+// Verifier validates a USI. It is the seam used by wrappers such as caching
+// and by downstream code that wants to substitute a fake in tests.
+type Verifier interface {
+	VerifyKey(key string) (bool, error)
+}
+
+// VerifierFunc adapts an ordinary function to the Verifier interface.
+type VerifierFunc func(key string) (bool, error)
+
+// VerifyKey calls f(key).
+func (f VerifierFunc) VerifyKey(key string) (bool, error) {
+	return f(key)
+}
+
+// DefaultVerifier is a Verifier backed by the package-level VerifyKey function
+// with no options.
+var DefaultVerifier Verifier = NewVerifier()
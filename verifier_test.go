@@ -0,0 +1,54 @@
+package usivalidator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/chrisjoyce911/usivalidator/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	_ Verifier = (*mocks.Verifier)(nil)
+	_ Cache    = (*mocks.Cache)(nil)
+)
+
+func TestDefaultVerifier(t *testing.T) {
+	testCases := []struct {
+		USI      string
+		Expected bool
+		TestName string
+	}{
+		{"BNGH7C75FN", true, "Valid USI"},
+		{"BNGH7C75FX", false, "Wrong check character"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			isValid, err := DefaultVerifier.VerifyKey(tc.USI)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.Expected, isValid)
+		})
+	}
+}
+
+func TestVerifierFunc(t *testing.T) {
+	errBoom := errors.New("boom")
+	v := VerifierFunc(func(key string) (bool, error) {
+		return false, errBoom
+	})
+
+	isValid, err := v.VerifyKey("BNGH7C75FN")
+	assert.False(t, isValid)
+	assert.Equal(t, errBoom, err)
+}
+
+func TestMockVerifier(t *testing.T) {
+	v := mocks.NewVerifier(t)
+	v.EXPECT().VerifyKey("BNGH7C75FN").Return(true, nil).Once()
+
+	var verifier Verifier = v
+	isValid, err := verifier.VerifyKey("BNGH7C75FN")
+	assert.True(t, isValid)
+	assert.NoError(t, err)
+}
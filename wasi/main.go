@@ -0,0 +1,69 @@
+/*
+Command wasi is a line-oriented USI validator intended for WebAssembly
+System Interface (wasip1) runtimes such as edge functions and plugin sandboxes.
+It reads one key per line from standard input and writes one result per line
+to standard output, so it needs no file system or network access.
+
+Build it with:
+
+	GOOS=wasip1 GOARCH=wasm go build -o usivalidator.wasm ./wasi
+
+and run it with any WASI runtime, for example:
+
+	echo BNGH7C75FN | wasmtime usivalidator.wasm
+
+Each output line holds the key, a tab, and "valid", "invalid" or
+"error: <reason>". The exit status is 1 if any key was not valid.
+*/
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/chrisjoyce911/usivalidator"
+)
+
+func main() {
+	allValid, err := run(os.Stdin, os.Stdout)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(2)
+	}
+	if !allValid {
+		os.Exit(1)
+	}
+}
+
+// run validates each non-blank line of r and writes the results to w. It
+// reports whether every key was valid.
+func run(r io.Reader, w io.Writer) (bool, error) {
+	out := bufio.NewWriter(w)
+	allValid := true
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		key := strings.TrimSpace(scanner.Text())
+		if key == "" {
+			continue
+		}
+
+		isValid, err := usivalidator.VerifyKey(key)
+		switch {
+		case err != nil:
+			fmt.Fprintf(out, "%s\terror: %v\n", key, err)
+		case isValid:
+			fmt.Fprintf(out, "%s\tvalid\n", key)
+		default:
+			fmt.Fprintf(out, "%s\tinvalid\n", key)
+		}
+		allValid = allValid && isValid
+	}
+	if err := scanner.Err(); err != nil {
+		return false, err
+	}
+	return allValid, out.Flush()
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	testCases := []struct {
+		Input    string
+		Expected string
+		AllValid bool
+		TestName string
+	}{
+		{"BNGH7C75FN\nBP6LKB3C7X\n", "BNGH7C75FN\tvalid\nBP6LKB3C7X\tvalid\n", true, "All valid"},
+		{"BNGH7C75FX\n\n  SHORT  \n", "BNGH7C75FX\tinvalid\nSHORT\terror: key length must be 10 characters\n", false, "Invalid and error"},
+		{"", "", true, "Empty input"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			var out bytes.Buffer
+			allValid, err := run(strings.NewReader(tc.Input), &out)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.AllValid, allValid)
+			assert.Equal(t, tc.Expected, out.String())
+		})
+	}
+}